@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"time"
 
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
@@ -26,7 +27,7 @@ func main() {
 	config := client.ClientConfig{
 		Name:    "simple-client-example",
 		Version: "1.0.0",
-		Logger:  log.Default(),
+		Logger:  slog.Default(),
 		Timeout: 30 * time.Second,
 	}
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"time"
 
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
@@ -58,7 +59,7 @@ func exampleTCPClient() error {
 	config := client.ClientConfig{
 		Name:    "transport-example-tcp",
 		Version: "1.0.0",
-		Logger:  log.Default(),
+		Logger:  slog.Default(),
 		Timeout: 30 * time.Second,
 	}
 
@@ -112,7 +113,7 @@ func exampleHTTPSSEClient() error {
 	config := client.ClientConfig{
 		Name:    "transport-example-sse",
 		Version: "1.0.0",
-		Logger:  log.Default(),
+		Logger:  slog.Default(),
 		Timeout: 30 * time.Second,
 	}
 
@@ -166,7 +167,7 @@ func exampleHTTPStreamingClient() error {
 	config := client.ClientConfig{
 		Name:    "transport-example-streaming",
 		Version: "1.0.0",
-		Logger:  log.Default(),
+		Logger:  slog.Default(),
 		Timeout: 30 * time.Second,
 	}
 
@@ -220,7 +221,7 @@ func exampleWebSocketClient() error {
 	config := client.ClientConfig{
 		Name:    "transport-example-ws",
 		Version: "1.0.0",
-		Logger:  log.Default(),
+		Logger:  slog.Default(),
 		Timeout: 30 * time.Second,
 	}
 
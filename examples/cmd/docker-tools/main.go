@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"os"
 	"time"
 
@@ -23,7 +24,7 @@ func main() {
 	clientConfig := client.ClientConfig{
 		Name:    "test-client",
 		Version: "1.0.0",
-		Logger:  log.New(os.Stdout, "[TEST] ", log.LstdFlags),
+		Logger:  slog.New(slog.NewTextHandler(os.Stdout, nil)),
 		Timeout: 30 * time.Second,
 	}
 
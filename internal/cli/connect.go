@@ -3,7 +3,6 @@ package cli
 import (
 	"context"
 	"fmt"
-	"log"
 	"os"
 	"strings"
 	"time"
@@ -67,10 +66,7 @@ func init() {
 }
 
 func runConnect(cmd *cobra.Command, args []string) {
-	logger := log.New(os.Stdout, "", 0)
-	if verbose {
-		logger = log.New(os.Stdout, "[MCP] ", log.LstdFlags)
-	}
+	logger := newLogger("mcp")
 
 	// Determine transport type from flags
 	tcpFlag, _ := cmd.Flags().GetBool("tcp")
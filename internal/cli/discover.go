@@ -3,7 +3,6 @@ package cli
 import (
 	"context"
 	"fmt"
-	"log"
 	"os"
 	"time"
 
@@ -53,12 +52,7 @@ func init() {
 }
 
 func runDiscover(cmd *cobra.Command, args []string) {
-	logger := log.New(os.Stdout, "", 0)
-	if verbose {
-		logger = log.New(os.Stdout, "[DISCOVERY] ", log.LstdFlags)
-	}
-
-	discoveryService := discovery.NewDiscovery(logger)
+	discoveryService := discovery.NewDiscovery(newLogger("discovery"))
 	discoveryService.SetTimeout(discoveryTimeout)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
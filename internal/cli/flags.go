@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// connectionFlags holds the transport flags shared by commands that talk to a single server
+type connectionFlags struct {
+	connType string
+	host     string
+	port     int
+	command  string
+	args     []string
+	url      string
+	endpoint string
+	timeout  time.Duration
+}
+
+// register adds the connection flags to cmd (same flags as the connect command)
+func (f *connectionFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.connType, "type", "tcp", "Connection type: tcp, stdio, docker, or http")
+	cmd.Flags().BoolP("tcp", "t", false, "Use TCP transport")
+	cmd.Flags().BoolP("stdio", "s", false, "Use STDIO transport")
+	cmd.Flags().BoolP("docker", "d", false, "Use Docker transport (alpine/socat)")
+	cmd.Flags().Bool("http", false, "Use HTTP/SSE transport")
+
+	cmd.Flags().StringVar(&f.host, "host", "localhost", "TCP host to connect to")
+	cmd.Flags().IntVar(&f.port, "port", 8811, "TCP port to connect to")
+	cmd.Flags().StringVar(&f.command, "command", "", "Command to execute for STDIO transport")
+	cmd.Flags().StringSliceVar(&f.args, "args", []string{}, "Arguments for the command")
+	cmd.Flags().StringVar(&f.url, "url", "http://localhost:8812", "Base URL for HTTP transport")
+	cmd.Flags().StringVar(&f.endpoint, "endpoint", "/mcp", "Endpoint path for HTTP transport")
+	cmd.Flags().DurationVar(&f.timeout, "timeout", 30*time.Second, "Connection timeout")
+}
+
+// newTransport creates the transport selected by the flags
+func (f *connectionFlags) newTransport(cmd *cobra.Command) (transport.Transport, error) {
+	transportType := f.connType
+	for _, name := range []string{"tcp", "stdio", "docker", "http"} {
+		if set, _ := cmd.Flags().GetBool(name); set {
+			transportType = name
+			break
+		}
+	}
+
+	fmt.Printf("🔌 Connecting to MCP server using %s transport...\n", transportType)
+
+	switch transportType {
+	case "tcp":
+		fmt.Printf("   Host: %s:%d\n", f.host, f.port)
+		return transport.NewTCPTransport(f.host, f.port), nil
+
+	case "stdio":
+		if f.command == "" {
+			return nil, fmt.Errorf("STDIO transport requires --command flag")
+		}
+		fmt.Printf("   Command: %s %s\n", f.command, strings.Join(f.args, " "))
+		return transport.NewStdioTransport(f.command, f.args), nil
+
+	case "docker":
+		fmt.Println("   Using Docker alpine/socat -> host.docker.internal:8811")
+		return transport.NewStdioTransport("docker", []string{
+			"run", "-i", "--rm", "alpine/socat",
+			"STDIO", "TCP:host.docker.internal:8811",
+		}), nil
+
+	case "http":
+		fmt.Printf("   URL: %s%s\n", f.url, f.endpoint)
+		if strings.Contains(f.endpoint, "sse") {
+			return transport.NewSSETransport(f.url, f.endpoint), nil
+		}
+		return transport.NewStreamingHTTPTransport(f.url, f.endpoint), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported transport type: %s", transportType)
+	}
+}
+
+// connect creates, connects and initializes a client, exiting the process on failure.
+// The caller must Disconnect the returned client.
+func (f *connectionFlags) connect(ctx context.Context, cmd *cobra.Command, config client.ClientConfig) *client.Client {
+	mcpClient := f.open(ctx, cmd, config)
+	f.initialize(ctx, mcpClient)
+	return mcpClient
+}
+
+// open creates and connects a client, exiting the process on failure. A STDIO
+// server lives only as long as ctx, so long-running commands pass a context
+// that outlives their setup timeout. The caller must Disconnect the returned client.
+func (f *connectionFlags) open(ctx context.Context, cmd *cobra.Command, config client.ClientConfig) *client.Client {
+	mcpTransport, err := f.newTransport(cmd)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	config.Name = "mcp-client-go"
+	config.Version = "1.0.0"
+	config.Timeout = f.timeout
+	if config.Logger == nil {
+		config.Logger = newLogger("mcp")
+	}
+
+	mcpClient := client.NewClient(mcpTransport, config)
+
+	if err := mcpClient.Connect(ctx); err != nil {
+		fmt.Printf("❌ Failed to connect: %v\n", err)
+		os.Exit(1)
+	}
+	return mcpClient
+}
+
+// initialize initializes the MCP protocol on a client from open, disconnecting
+// it and exiting the process on failure
+func (f *connectionFlags) initialize(ctx context.Context, mcpClient *client.Client) {
+	clientInfo := mcp.ClientInfo{
+		Name:    "mcp-client-go",
+		Version: "1.0.0",
+	}
+	if err := mcpClient.Initialize(ctx, clientInfo); err != nil {
+		fmt.Printf("❌ Failed to initialize MCP protocol: %v\n", err)
+		mcpClient.Disconnect()
+		os.Exit(1)
+	}
+
+	fmt.Println("✅ Connected and initialized MCP protocol")
+}
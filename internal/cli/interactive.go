@@ -4,10 +4,12 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +39,8 @@ and interacting with MCP servers. Available commands:
   list-tools              - List tools available on current server
   list-resources          - List resources available on current server
   call-tool <name> [args] - Execute a tool with optional JSON arguments
+  list-prompts            - List prompts available on current server
+  get-prompt <name>       - Fill in a prompt's arguments (Tab completes) and show it
   status                  - Show connection status
   exit/quit               - Exit interactive mode
 
@@ -54,7 +58,7 @@ func init() {
 }
 
 type InteractiveSession struct {
-	logger           *log.Logger
+	logger           *slog.Logger
 	discoveryService *discovery.Discovery
 	availableServers []discovery.ServerInfo
 	currentClient    *client.Client
@@ -70,8 +74,8 @@ type InteractiveSession struct {
 
 func runInteractive(cmd *cobra.Command, args []string) {
 	session := &InteractiveSession{
-		logger:           log.New(os.Stdout, "", 0),
-		discoveryService: discovery.NewDiscovery(nil),
+		logger:           newLogger("interactive"),
+		discoveryService: discovery.NewDiscovery(newLogger("discovery")),
 		reader:           bufio.NewReader(os.Stdin),
 		promptColor:      color.New(color.FgCyan, color.Bold),
 		successColor:     color.New(color.FgGreen),
@@ -79,10 +83,6 @@ func runInteractive(cmd *cobra.Command, args []string) {
 		infoColor:        color.New(color.FgBlue),
 	}
 
-	if verbose {
-		session.logger = log.New(os.Stdout, "[INTERACTIVE] ", log.LstdFlags)
-	}
-
 	session.start()
 }
 
@@ -133,6 +133,10 @@ func (s *InteractiveSession) start() {
 			s.listResources()
 		case "call-tool", "ct":
 			s.callTool(args)
+		case "list-prompts", "lp":
+			s.listPrompts()
+		case "get-prompt", "gp":
+			s.getPrompt(args)
 		case "status", "s":
 			s.showStatus()
 		case "exit", "quit", "q":
@@ -153,6 +157,8 @@ func (s *InteractiveSession) showHelp() {
 	fmt.Println("  list-tools        - List tools available on current server")
 	fmt.Println("  list-resources    - List resources available on current server")
 	fmt.Println("  call-tool <n> [args] - Call a tool with optional JSON arguments")
+	fmt.Println("  list-prompts      - List prompts available on current server")
+	fmt.Println("  get-prompt <n>    - Fill in a prompt's arguments (Tab completes) and show it")
 	fmt.Println("  status            - Show connection status")
 	fmt.Println("  exit/quit         - Exit the client")
 }
@@ -225,10 +231,12 @@ func (s *InteractiveSession) connectToServer(args []string) {
 
 	// Create client
 	clientConfig := client.ClientConfig{
-		Name:    "mcp-client-go",
-		Version: "1.0.0",
-		Logger:  s.logger,
-		Timeout: 30 * time.Second,
+		Name:               "mcp-client-go",
+		Version:            "1.0.0",
+		Logger:             s.logger,
+		Timeout:            30 * time.Second,
+		ElicitationHandler: client.ElicitationHandlerFunc(s.elicit),
+		CacheCatalog:       true,
 	}
 
 	s.currentClient = client.NewClient(selectedServer.Transport, clientConfig)
@@ -394,6 +402,280 @@ func (s *InteractiveSession) callTool(args []string) {
 	}
 }
 
+func (s *InteractiveSession) listPrompts() {
+	if s.currentClient == nil {
+		s.errorColor.Println("❌ No active connection. Use 'connect' first.")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	prompts, err := s.currentClient.ListPrompts(ctx)
+	if err != nil {
+		s.errorColor.Printf("❌ Failed to list prompts: %v\n", err)
+		return
+	}
+
+	if len(prompts) == 0 {
+		s.infoColor.Println("💬 No prompts available")
+		return
+	}
+
+	s.successColor.Printf("💬 Available prompts (%d):\n", len(prompts))
+	for i, prompt := range prompts {
+		fmt.Printf("  %d. %s\n", i+1, prompt.Name)
+		if prompt.Description != "" {
+			fmt.Printf("     Description: %s\n", prompt.Description)
+		}
+		for _, arg := range prompt.Arguments {
+			required := ""
+			if arg.Required {
+				required = " (required)"
+			}
+			fmt.Printf("     - %s%s\n", arg.Name, required)
+		}
+	}
+}
+
+// getPrompt asks for each argument of a prompt, offering server-side
+// completions on Tab, then fetches and prints the rendered prompt
+func (s *InteractiveSession) getPrompt(args []string) {
+	if s.currentClient == nil {
+		s.errorColor.Println("❌ No active connection. Use 'connect' first.")
+		return
+	}
+
+	if len(args) == 0 {
+		s.errorColor.Println("❌ Please specify a prompt name")
+		return
+	}
+
+	lookupCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	prompt, err := s.currentClient.Prompt(lookupCtx, args[0])
+	cancel()
+	if errors.Is(err, client.ErrNotFound) {
+		s.errorColor.Printf("❌ Prompt not found: %s\n", args[0])
+		return
+	}
+	if err != nil {
+		s.errorColor.Printf("❌ Failed to list prompts: %v\n", err)
+		return
+	}
+
+	if len(prompt.Arguments) > 0 {
+		s.infoColor.Println("📝 Enter prompt arguments (Tab for suggestions):")
+	}
+
+	filled := make(map[string]string)
+	for _, arg := range prompt.Arguments {
+		if arg.Description != "" {
+			fmt.Printf("   %s: %s\n", arg.Name, arg.Description)
+		}
+		label := "  " + arg.Name
+		if arg.Required {
+			label += " (required)"
+		}
+		label += ": "
+
+		argName := arg.Name
+		complete := func(input string) ([]string, bool, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			completion, err := s.currentClient.CompletePromptArgument(ctx, prompt.Name, argName, input, filled)
+			if err != nil {
+				return nil, false, err
+			}
+			more := completion.HasMore || completion.Total > len(completion.Values)
+			return completion.Values, more, nil
+		}
+
+		for {
+			value, err := s.readLineWithCompletion(label, complete)
+			if err != nil {
+				s.infoColor.Println("   Prompt cancelled")
+				return
+			}
+			if value == "" && arg.Required {
+				s.errorColor.Println("   A value is required")
+				continue
+			}
+			if value != "" {
+				filled[arg.Name] = value
+			}
+			break
+		}
+	}
+
+	arguments := make(map[string]interface{}, len(filled))
+	for name, value := range filled {
+		arguments[name] = value
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	result, err := s.currentClient.GetPrompt(ctx, prompt.Name, arguments)
+	if err != nil {
+		s.errorColor.Printf("❌ Failed to get prompt: %v\n", err)
+		return
+	}
+
+	s.successColor.Printf("💬 Prompt %s:\n", prompt.Name)
+	if result.Description != "" {
+		fmt.Printf("   %s\n", result.Description)
+	}
+	for _, message := range result.Messages {
+		switch message.Content.Type {
+		case "text":
+			fmt.Printf("\n[%s]\n%s\n", message.Role, message.Content.Text)
+		default:
+			fmt.Printf("\n[%s] (%s content)\n", message.Role, message.Content.Type)
+		}
+	}
+}
+
+// elicit renders an elicitation request from the server as a prompt form.
+// Requests normally arrive while a command is waiting on the server, so the
+// main loop is not reading from stdin at the same time.
+func (s *InteractiveSession) elicit(ctx context.Context, request *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+	s.infoColor.Println("\n📝 The server is requesting information:")
+	fmt.Printf("   %s\n", request.Message)
+
+	answer, err := s.readLine("Provide it? [y]es / [n]o / [c]ancel: ")
+	if err != nil {
+		return &mcp.ElicitResult{Action: mcp.ElicitActionCancel}, nil
+	}
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+	case "n", "no":
+		return &mcp.ElicitResult{Action: mcp.ElicitActionDecline}, nil
+	default:
+		return &mcp.ElicitResult{Action: mcp.ElicitActionCancel}, nil
+	}
+
+	properties, _ := request.RequestedSchema["properties"].(map[string]interface{})
+	required := make(map[string]bool)
+	if names, ok := request.RequestedSchema["required"].([]interface{}); ok {
+		for _, name := range names {
+			if str, ok := name.(string); ok {
+				required[str] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	content := make(map[string]interface{})
+	for _, name := range names {
+		property, _ := properties[name].(map[string]interface{})
+		value, ok, err := s.promptField(name, property, required[name])
+		if err != nil {
+			return &mcp.ElicitResult{Action: mcp.ElicitActionCancel}, nil
+		}
+		if ok {
+			content[name] = value
+		}
+	}
+
+	return &mcp.ElicitResult{Action: mcp.ElicitActionAccept, Content: content}, nil
+}
+
+// promptField asks for a single form field until a valid value is entered.
+// It returns ok=false when an optional field is left empty.
+func (s *InteractiveSession) promptField(name string, property map[string]interface{}, required bool) (interface{}, bool, error) {
+	label := name
+	if title, ok := property["title"].(string); ok && title != "" {
+		label = title
+	}
+	fieldType, _ := property["type"].(string)
+	enum, _ := property["enum"].([]interface{})
+
+	if description, ok := property["description"].(string); ok && description != "" {
+		fmt.Printf("   %s: %s\n", label, description)
+	}
+	for i, option := range enum {
+		fmt.Printf("     %d. %v\n", i+1, option)
+	}
+
+	prompt := "  " + label
+	if required {
+		prompt += " (required)"
+	}
+	if def, ok := property["default"]; ok {
+		prompt += fmt.Sprintf(" [%v]", def)
+	}
+	prompt += ": "
+
+	for {
+		input, err := s.readLine(prompt)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if input == "" {
+			if def, ok := property["default"]; ok {
+				return def, true, nil
+			}
+			if !required {
+				return nil, false, nil
+			}
+			s.errorColor.Println("   A value is required")
+			continue
+		}
+
+		if len(enum) > 0 {
+			if index, err := strconv.Atoi(input); err == nil && index > 0 && index <= len(enum) {
+				return enum[index-1], true, nil
+			}
+			for _, option := range enum {
+				if fmt.Sprint(option) == input {
+					return option, true, nil
+				}
+			}
+			s.errorColor.Println("   Please choose one of the listed options")
+			continue
+		}
+
+		switch fieldType {
+		case "number":
+			if value, err := strconv.ParseFloat(input, 64); err == nil {
+				return value, true, nil
+			}
+			s.errorColor.Println("   Please enter a number")
+		case "integer":
+			if value, err := strconv.ParseInt(input, 10, 64); err == nil {
+				return value, true, nil
+			}
+			s.errorColor.Println("   Please enter a whole number")
+		case "boolean":
+			switch strings.ToLower(input) {
+			case "y", "yes", "true":
+				return true, true, nil
+			case "n", "no", "false":
+				return false, true, nil
+			}
+			s.errorColor.Println("   Please enter yes or no")
+		default:
+			return input, true, nil
+		}
+	}
+}
+
+// readLine prints a prompt and reads one trimmed line of input
+func (s *InteractiveSession) readLine(prompt string) (string, error) {
+	s.promptColor.Print(prompt)
+	input, err := s.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(input), nil
+}
+
 func (s *InteractiveSession) showStatus() {
 	fmt.Println("\n📊 Status:")
 	fmt.Printf("  Available servers: %d\n", len(s.availableServers))
@@ -403,6 +685,7 @@ func (s *InteractiveSession) showStatus() {
 		if serverInfo := s.currentClient.GetServerInfo(); serverInfo != nil {
 			fmt.Printf("  Server info: %s %s\n", serverInfo.Name, serverInfo.Version)
 		}
+		fmt.Printf("  Health: %s\n", s.currentClient.Health())
 	} else {
 		fmt.Println("  Current connection: None ❌")
 	}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// errInterrupted is returned when Ctrl+C is pressed while editing a line
+var errInterrupted = errors.New("interrupted")
+
+// completer suggests values for the text typed so far; more reports that the
+// server has additional suggestions beyond the ones returned
+type completer func(input string) (values []string, more bool, err error)
+
+// readLineWithCompletion prints a prompt and reads one trimmed line of input,
+// calling complete when Tab is pressed. A single suggestion replaces the input;
+// several are listed below the prompt and the input is extended to their
+// common prefix. When stdin is not a terminal it behaves like readLine.
+func (s *InteractiveSession) readLineWithCompletion(prompt string, complete completer) (string, error) {
+	restore, err := enableRawInput(int(os.Stdin.Fd()))
+	if err != nil {
+		return s.readLine(prompt)
+	}
+	defer restore()
+
+	var line []rune
+	redraw := func() {
+		fmt.Print("\r\033[K")
+		s.promptColor.Print(prompt)
+		fmt.Print(string(line))
+	}
+	redraw()
+
+	for {
+		r, _, err := s.reader.ReadRune()
+		if err != nil {
+			fmt.Println()
+			return "", err
+		}
+
+		switch r {
+		case '\r', '\n':
+			fmt.Println()
+			return strings.TrimSpace(string(line)), nil
+
+		case 3: // Ctrl+C
+			fmt.Println("^C")
+			return "", errInterrupted
+
+		case 4: // Ctrl+D
+			if len(line) == 0 {
+				fmt.Println()
+				return "", io.EOF
+			}
+
+		case 127, '\b':
+			if len(line) > 0 {
+				line = line[:len(line)-1]
+				redraw()
+			}
+
+		case 21: // Ctrl+U
+			line = nil
+			redraw()
+
+		case 27: // Escape sequences such as arrow keys are ignored
+			s.skipEscapeSequence()
+
+		case '\t':
+			values, more, err := complete(string(line))
+			if err != nil {
+				fmt.Println()
+				s.errorColor.Printf("   Completion failed: %v\n", err)
+				redraw()
+				continue
+			}
+
+			switch len(values) {
+			case 0:
+				fmt.Print("\a")
+			case 1:
+				line = []rune(values[0])
+				redraw()
+			default:
+				if prefix := commonPrefix(values); len(prefix) > len(string(line)) && strings.HasPrefix(prefix, string(line)) {
+					line = []rune(prefix)
+				}
+				fmt.Println()
+				s.infoColor.Printf("   %s", strings.Join(values, "  "))
+				if more {
+					s.infoColor.Print("  …")
+				}
+				fmt.Println()
+				redraw()
+			}
+
+		default:
+			if unicode.IsPrint(r) {
+				line = append(line, r)
+				fmt.Print(string(r))
+			}
+		}
+	}
+}
+
+// skipEscapeSequence discards the rest of a CSI or SS3 escape sequence
+func (s *InteractiveSession) skipEscapeSequence() {
+	r, _, err := s.reader.ReadRune()
+	if err != nil || (r != '[' && r != 'O') {
+		return
+	}
+	for {
+		r, _, err := s.reader.ReadRune()
+		if err != nil || unicode.IsLetter(r) || r == '~' {
+			return
+		}
+	}
+}
+
+// commonPrefix returns the longest prefix shared by all values
+func commonPrefix(values []string) string {
+	prefix := values[0]
+	for _, value := range values[1:] {
+		for !strings.HasPrefix(value, prefix) {
+			_, size := utf8.DecodeLastRuneInString(prefix)
+			prefix = prefix[:len(prefix)-size]
+		}
+	}
+	return prefix
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var (
+	logsConn  connectionFlags
+	logsLevel string
+	logsFile  string
+)
+
+// logsCmd represents the logs command
+var logsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Tail an MCP server's log messages",
+	Long: `Connect to an MCP server, set its log level and print the log messages it
+sends until interrupted with Ctrl+C.
+
+Levels, from most to least verbose: debug, info, notice, warning, error,
+critical, alert, emergency. The server must support the logging capability.
+
+Examples:
+  mcp-client logs --tcp --host localhost --port 8811
+  mcp-client logs --level debug --stdio --command node --args server.js
+  mcp-client logs --level warning --file server-logs.jsonl --http --url http://localhost:8812`,
+	Args: cobra.NoArgs,
+	Run:  runLogs,
+}
+
+func init() {
+	rootCmd.AddCommand(logsCmd)
+
+	logsConn.register(logsCmd)
+	logsCmd.Flags().StringVar(&logsLevel, "level", "info", "Minimum log level to request from the server")
+	logsCmd.Flags().StringVar(&logsFile, "file", "", "Also append log entries to this file as JSON lines")
+}
+
+func runLogs(cmd *cobra.Command, args []string) {
+	level, err := mcp.ParseLogLevel(logsLevel)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	config := client.ClientConfig{LogSink: client.LogSinkFunc(printLogEntry)}
+	if logsFile != "" {
+		fileSink, err := client.NewFileLogSink(logsFile)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		defer fileSink.Close()
+		config.LogSink = client.LogSinkFunc(func(entry mcp.LoggingMessageNotification) {
+			printLogEntry(entry)
+			fileSink.HandleLog(entry)
+		})
+	}
+
+	// The timeout only applies to setup; the connection lives until Ctrl+C, as a
+	// STDIO server would otherwise be stopped once the timeout runs out
+	setupCtx, cancel := context.WithTimeout(ctx, logsConn.timeout)
+	defer cancel()
+
+	mcpClient := logsConn.open(ctx, cmd, config)
+	defer mcpClient.Disconnect()
+	logsConn.initialize(setupCtx, mcpClient)
+
+	if err := mcpClient.SetLogLevel(setupCtx, level); err != nil {
+		fmt.Printf("❌ Failed to set log level: %v\n", err)
+		return
+	}
+
+	fmt.Printf("📜 Tailing server logs at level %s and above (Ctrl+C to stop)...\n", level)
+	<-ctx.Done()
+	fmt.Println("\n🛑 Stopped tailing logs")
+}
+
+// printLogEntry prints one server log entry, colored by severity
+func printLogEntry(entry mcp.LoggingMessageNotification) {
+	levelColor := color.New(color.FgWhite)
+	switch severity := entry.Level.Severity(); {
+	case severity >= mcp.LogLevelError.Severity():
+		levelColor = color.New(color.FgRed, color.Bold)
+	case severity == mcp.LogLevelWarning.Severity():
+		levelColor = color.New(color.FgYellow)
+	case severity == mcp.LogLevelDebug.Severity():
+		levelColor = color.New(color.FgHiBlack)
+	}
+
+	message, ok := entry.Data.(string)
+	if !ok {
+		data, _ := json.Marshal(entry.Data)
+		message = string(data)
+	}
+
+	fmt.Printf("%s ", time.Now().Format("15:04:05"))
+	levelColor.Printf("%-9s", entry.Level)
+	if entry.Logger != "" {
+		fmt.Printf(" [%s]", entry.Logger)
+	}
+	fmt.Printf(" %s\n", message)
+}
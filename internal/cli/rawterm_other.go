@@ -0,0 +1,10 @@
+//go:build !linux && !darwin && !dragonfly && !freebsd && !netbsd && !openbsd
+
+package cli
+
+import "errors"
+
+// enableRawInput is not supported on this platform; callers fall back to line input
+func enableRawInput(fd int) (func(), error) {
+	return nil, errors.New("raw terminal input not supported on this platform")
+}
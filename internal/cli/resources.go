@@ -0,0 +1,304 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	resourcesConn connectionFlags
+	templateVars  []string
+)
+
+// resourcesCmd represents the resources command
+var resourcesCmd = &cobra.Command{
+	Use:   "resources",
+	Short: "Browse resources on an MCP server",
+	Long: `Browse the resources and resource templates exposed by an MCP server.
+
+Examples:
+  mcp-client resources list --tcp --host localhost --port 8811
+  mcp-client resources read file:///etc/hosts --stdio --command node --args server.js
+  mcp-client resources watch file:///var/log/app.log --tcp
+  mcp-client resources templates --http --url http://localhost:8812
+  mcp-client resources templates "Project file" --var path=src/main.go --tcp`,
+}
+
+// resourcesListCmd lists concrete resources
+var resourcesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List resources available on the server",
+	Args:  cobra.NoArgs,
+	Run:   runResourcesList,
+}
+
+// resourcesReadCmd reads a resource by URI
+var resourcesReadCmd = &cobra.Command{
+	Use:   "read <uri>",
+	Short: "Read a resource by URI",
+	Args:  cobra.ExactArgs(1),
+	Run:   runResourcesRead,
+}
+
+// resourcesWatchCmd re-reads a resource whenever the server reports a change
+var resourcesWatchCmd = &cobra.Command{
+	Use:   "watch <uri>",
+	Short: "Read a resource and re-read it whenever it changes",
+	Long: `Read a resource, subscribe to it and re-read it every time the server
+sends notifications/resources/updated. Press Ctrl+C to stop watching.
+
+The server must support resource subscriptions.`,
+	Args: cobra.ExactArgs(1),
+	Run:  runResourcesWatch,
+}
+
+// resourcesTemplatesCmd lists resource templates and reads expanded templates
+var resourcesTemplatesCmd = &cobra.Command{
+	Use:   "templates [name|index]",
+	Short: "List resource templates, or fill one in and read it",
+	Long: `List the resource templates exposed by the server.
+
+When a template name or index is given, its variables are filled from --var
+flags (prompting for any that are missing), the URI template is expanded and
+the resulting resource is read.`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  runResourcesTemplates,
+}
+
+func init() {
+	rootCmd.AddCommand(resourcesCmd)
+	resourcesCmd.AddCommand(resourcesListCmd, resourcesReadCmd, resourcesWatchCmd, resourcesTemplatesCmd)
+
+	for _, cmd := range []*cobra.Command{resourcesListCmd, resourcesReadCmd, resourcesWatchCmd, resourcesTemplatesCmd} {
+		resourcesConn.register(cmd)
+	}
+	resourcesTemplatesCmd.Flags().StringArrayVar(&templateVars, "var", nil, "Template variable as name=value (repeatable)")
+}
+
+func runResourcesList(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), resourcesConn.timeout)
+	defer cancel()
+
+	mcpClient := resourcesConn.connect(ctx, cmd, client.ClientConfig{})
+	defer mcpClient.Disconnect()
+
+	resources, err := mcpClient.ListResources(ctx)
+	if err != nil {
+		fmt.Printf("❌ Failed to list resources: %v\n", err)
+		return
+	}
+
+	if len(resources) == 0 {
+		fmt.Println("   No resources available")
+		return
+	}
+	fmt.Printf("📄 Available resources (%d):\n", len(resources))
+	for i, resource := range resources {
+		fmt.Printf("  %d. %s\n", i+1, resource.Name)
+		if resource.Description != "" {
+			fmt.Printf("     Description: %s\n", resource.Description)
+		}
+		fmt.Printf("     URI: %s\n", resource.URI)
+	}
+}
+
+func runResourcesRead(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), resourcesConn.timeout)
+	defer cancel()
+
+	mcpClient := resourcesConn.connect(ctx, cmd, client.ClientConfig{})
+	defer mcpClient.Disconnect()
+
+	result, err := mcpClient.ReadResource(ctx, args[0])
+	if err != nil {
+		fmt.Printf("❌ Failed to read resource: %v\n", err)
+		return
+	}
+	printResourceContents(args[0], result)
+}
+
+func runResourcesWatch(cmd *cobra.Command, args []string) {
+	uri := args[0]
+
+	// Stop on Ctrl+C; the connection timeout only applies to setup and each read.
+	// The connection itself uses ctx, as a STDIO server would otherwise be
+	// stopped once the timeout runs out.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	setupCtx, cancel := context.WithTimeout(ctx, resourcesConn.timeout)
+	defer cancel()
+
+	mcpClient := resourcesConn.open(ctx, cmd, client.ClientConfig{})
+	defer mcpClient.Disconnect()
+	resourcesConn.initialize(setupCtx, mcpClient)
+
+	sub, err := mcpClient.SubscribeResource(setupCtx, uri)
+	if err != nil {
+		fmt.Printf("❌ Failed to subscribe: %v\n", err)
+		return
+	}
+	defer sub.Close(context.Background())
+
+	read := func() {
+		readCtx, cancel := context.WithTimeout(ctx, resourcesConn.timeout)
+		defer cancel()
+		result, err := mcpClient.ReadResource(readCtx, uri)
+		if err != nil {
+			fmt.Printf("❌ Failed to read resource: %v\n", err)
+			return
+		}
+		printResourceContents(uri, result)
+	}
+
+	read()
+	fmt.Printf("\n👀 Watching %s for changes (Ctrl+C to stop)...\n", uri)
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Println("\n🛑 Stopped watching")
+			return
+		case _, ok := <-sub.Updates():
+			if !ok {
+				return
+			}
+			fmt.Printf("\n🔔 %s changed at %s\n", uri, time.Now().Format("15:04:05"))
+			read()
+		}
+	}
+}
+
+func runResourcesTemplates(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), resourcesConn.timeout)
+	defer cancel()
+
+	mcpClient := resourcesConn.connect(ctx, cmd, client.ClientConfig{})
+	defer mcpClient.Disconnect()
+
+	templates, err := mcpClient.ListResourceTemplates(ctx)
+	if err != nil {
+		fmt.Printf("❌ Failed to list resource templates: %v\n", err)
+		return
+	}
+
+	if len(args) == 0 {
+		if len(templates) == 0 {
+			fmt.Println("   No resource templates available")
+			return
+		}
+		fmt.Printf("🧩 Resource templates (%d):\n", len(templates))
+		for i, template := range templates {
+			fmt.Printf("  %d. %s\n", i+1, template.Name)
+			fmt.Printf("     URI template: %s\n", template.URITemplate)
+			if template.Description != "" {
+				fmt.Printf("     Description: %s\n", template.Description)
+			}
+			if vars, err := template.Variables(); err == nil && len(vars) > 0 {
+				fmt.Printf("     Variables: %s\n", strings.Join(vars, ", "))
+			}
+		}
+		return
+	}
+
+	template, ok := findTemplate(templates, args[0])
+	if !ok {
+		fmt.Printf("❌ Resource template not found: %s\n", args[0])
+		os.Exit(1)
+	}
+
+	vars, err := fillTemplateVars(template, templateVars, bufio.NewReader(os.Stdin))
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	uri, err := template.Expand(vars)
+	if err != nil {
+		fmt.Printf("❌ Failed to expand template: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("🔗 Expanded URI: %s\n", uri)
+
+	result, err := mcpClient.ReadResource(ctx, uri)
+	if err != nil {
+		fmt.Printf("❌ Failed to read resource: %v\n", err)
+		return
+	}
+	printResourceContents(uri, result)
+}
+
+// findTemplate looks a template up by name or 1-based index
+func findTemplate(templates []mcp.ResourceTemplate, key string) (mcp.ResourceTemplate, bool) {
+	for _, template := range templates {
+		if template.Name == key || template.URITemplate == key {
+			return template, true
+		}
+	}
+	var index int
+	if _, err := fmt.Sscanf(key, "%d", &index); err == nil && index >= 1 && index <= len(templates) {
+		return templates[index-1], true
+	}
+	return mcp.ResourceTemplate{}, false
+}
+
+// fillTemplateVars collects values for the template's variables from name=value
+// assignments, prompting on in for any that were not given
+func fillTemplateVars(template mcp.ResourceTemplate, assignments []string, in *bufio.Reader) (map[string]interface{}, error) {
+	names, err := template.Variables()
+	if err != nil {
+		return nil, fmt.Errorf("invalid URI template %q: %w", template.URITemplate, err)
+	}
+
+	vars := make(map[string]interface{})
+	for _, assignment := range assignments {
+		name, value, ok := strings.Cut(assignment, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --var %q, expected name=value", assignment)
+		}
+		vars[name] = value
+	}
+
+	for _, name := range names {
+		if _, ok := vars[name]; ok {
+			continue
+		}
+		fmt.Printf("%s: ", name)
+		line, err := in.ReadString('\n')
+		if err != nil && line == "" {
+			return nil, fmt.Errorf("failed to read value for %s: %w", name, err)
+		}
+		vars[name] = strings.TrimSpace(line)
+	}
+
+	return vars, nil
+}
+
+// printResourceContents prints the content items of a read resource
+func printResourceContents(uri string, result *mcp.ReadResourceResponse) {
+	fmt.Printf("\n📄 Contents of %s:\n", uri)
+	for i, content := range result.Contents {
+		if len(result.Contents) > 1 {
+			fmt.Printf("\n--- Content %d ---\n", i+1)
+		}
+		if content.MimeType != "" {
+			fmt.Printf("MIME type: %s\n", content.MimeType)
+		}
+		if content.Text != "" {
+			fmt.Println(content.Text)
+		} else if content.Data != "" {
+			fmt.Printf("Binary data: %d bytes (base64)\n", len(content.Data))
+		}
+	}
+}
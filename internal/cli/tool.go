@@ -3,13 +3,15 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
 	"os"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/jsonschema"
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
 
@@ -25,6 +27,7 @@ var (
 	toolTimeout   time.Duration
 	toolName      string
 	toolArguments string
+	toolProgress  bool
 )
 
 // toolCmd represents the tool command
@@ -61,16 +64,14 @@ func init() {
 	// Tool-specific flags
 	toolCmd.Flags().StringVar(&toolName, "name", "", "Name of the tool to execute (required)")
 	toolCmd.Flags().StringVar(&toolArguments, "arguments", "{}", "JSON arguments for the tool")
+	toolCmd.Flags().BoolVar(&toolProgress, "progress", true, "Show a live progress bar if the server reports progress")
 
 	// Mark required flags
 	toolCmd.MarkFlagRequired("name")
 }
 
 func runTool(cmd *cobra.Command, args []string) {
-	logger := log.New(os.Stdout, "", 0)
-	if verbose {
-		logger = log.New(os.Stdout, "[TOOL] ", log.LstdFlags)
-	}
+	logger := newLogger("tool")
 
 	// Determine transport type from flags
 	tcpFlag, _ := cmd.Flags().GetBool("tcp")
@@ -124,6 +125,9 @@ func runTool(cmd *cobra.Command, args []string) {
 		Version: "1.0.0",
 		Logger:  logger,
 		Timeout: toolTimeout,
+
+		// Loads the tool list so arguments are checked against the input schema
+		CacheCatalog: true,
 	}
 
 	mcpClient := client.NewClient(mcpTransport, clientConfig)
@@ -166,7 +170,27 @@ func runTool(cmd *cobra.Command, args []string) {
 		fmt.Printf("📝 Arguments: %s\n", toolArguments)
 	}
 
-	result, err := mcpClient.CallTool(ctx, toolName, arguments)
+	var callOpts []client.CallToolOption
+	var progressShown atomic.Bool
+	if toolProgress {
+		callOpts = append(callOpts, client.WithProgress(func(p mcp.ProgressNotification) {
+			progressShown.Store(true)
+			printProgress(p)
+		}))
+	}
+
+	result, err := mcpClient.CallTool(ctx, toolName, arguments, callOpts...)
+	if progressShown.Load() {
+		fmt.Println()
+	}
+	var validationErr *jsonschema.ValidationError
+	if errors.As(err, &validationErr) {
+		fmt.Println("❌ Invalid arguments:")
+		for _, fieldErr := range validationErr.Errors {
+			fmt.Printf("   %s\n", fieldErr)
+		}
+		os.Exit(1)
+	}
 	if err != nil {
 		fmt.Printf("❌ Tool execution failed: %v\n", err)
 		os.Exit(1)
@@ -202,5 +226,33 @@ func runTool(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	if result.StructuredContent != nil {
+		structured, _ := json.MarshalIndent(result.StructuredContent, "", "  ")
+		fmt.Printf("\n📦 Structured content:\n%s\n", structured)
+	}
+
 	fmt.Println("\n✅ Tool execution completed")
 }
+
+// printProgress renders a progress update as a single line that is redrawn in place
+func printProgress(p mcp.ProgressNotification) {
+	const width = 30
+
+	if p.Total > 0 {
+		ratio := p.Progress / p.Total
+		if ratio < 0 {
+			ratio = 0
+		} else if ratio > 1 {
+			ratio = 1
+		}
+		filled := int(ratio * width)
+		fmt.Printf("\r⏳ [%s%s] %3.0f%%", strings.Repeat("█", filled), strings.Repeat("░", width-filled), ratio*100)
+	} else {
+		fmt.Printf("\r⏳ Progress: %v", p.Progress)
+	}
+
+	if p.Message != "" {
+		fmt.Printf(" %s", p.Message)
+	}
+	fmt.Print("\033[K") // Clear the rest of a longer previous line
+}
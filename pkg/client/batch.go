@@ -0,0 +1,270 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+)
+
+// Batch collects requests to send to the server together, as one JSON-RPC
+// batch. Create one with Client.Batch, add requests and call Send:
+//
+//	results, err := c.Batch().
+//		CallTool("search", map[string]interface{}{"query": "mcp"}).
+//		ReadResource("file:///README.md").
+//		Send(ctx)
+//
+// Only protocol version 2025-03-26 allows batches. With any other negotiated
+// version, or a transport that doesn't implement transport.BatchSender, Send
+// falls back to sending the requests one after the other. Either way each
+// request passes through the request interceptors on its own.
+//
+// A Batch is not safe for concurrent use.
+type Batch struct {
+	client *Client
+	items  []batchItem
+}
+
+// batchItem is one request of a batch
+type batchItem struct {
+	method string
+	params interface{}
+	check  func(ctx context.Context) error // Runs before sending, e.g. to validate arguments
+}
+
+// BatchResult is the outcome of one request of a batch
+type BatchResult struct {
+	Method string
+
+	// Response is the server's response, nil if none arrived
+	Response *mcp.Message
+
+	// Err is why the request failed: a transport error, a timeout, or the
+	// server's JSON-RPC error as an *MCPError, in which case Response is set
+	Err error
+}
+
+// Decode parses the result of a successful request into target, such as an
+// *mcp.CallToolResponse for a CallTool request
+func (r BatchResult) Decode(target interface{}) error {
+	if r.Err != nil {
+		return r.Err
+	}
+	return decodeResult(r.Response.Result, target)
+}
+
+// Batch starts a batch of requests (see Batch)
+func (c *Client) Batch() *Batch {
+	return &Batch{client: c}
+}
+
+// Add adds a request for method with params
+func (b *Batch) Add(method string, params interface{}) *Batch {
+	b.items = append(b.items, batchItem{method: method, params: params})
+	return b
+}
+
+// CallTool adds a tools/call request. Arguments are validated against the
+// tool's input schema when the batch is sent, as in Client.CallTool.
+func (b *Batch) CallTool(name string, arguments map[string]interface{}) *Batch {
+	b.items = append(b.items, batchItem{
+		method: "tools/call",
+		params: mcp.CallToolRequest{Name: name, Arguments: arguments},
+		check: func(ctx context.Context) error {
+			return b.client.validateToolArguments(ctx, name, arguments)
+		},
+	})
+	return b
+}
+
+// ReadResource adds a resources/read request
+func (b *Batch) ReadResource(uri string) *Batch {
+	return b.Add("resources/read", mcp.ReadResourceRequest{URI: uri})
+}
+
+// GetPrompt adds a prompts/get request
+func (b *Batch) GetPrompt(name string, arguments map[string]interface{}) *Batch {
+	return b.Add("prompts/get", mcp.GetPromptRequest{Name: name, Arguments: arguments})
+}
+
+// Len returns the number of requests in the batch
+func (b *Batch) Len() int {
+	return len(b.items)
+}
+
+// Send sends the requests and waits for all of their responses. The
+// results are in the order the requests were added; a request failing
+// doesn't fail the others. A batch of one request is sent as a plain request.
+func (b *Batch) Send(ctx context.Context) ([]BatchResult, error) {
+	c := b.client
+	if !c.IsInitialized() {
+		return nil, ErrNotInitialized
+	}
+
+	results := make([]BatchResult, len(b.items))
+	sender, ok := c.transport.(transport.BatchSender)
+	if !ok || len(b.items) < 2 || !mcp.ProtocolVersionSupportsBatching(c.ProtocolVersion()) {
+		for i, item := range b.items {
+			results[i] = item.run(ctx, c.invoke)
+		}
+		return results, nil
+	}
+
+	frame := &batchFrame{
+		client:  c,
+		sender:  sender,
+		joined:  make([]bool, len(b.items)),
+		waiting: len(b.items),
+		sent:    make(chan struct{}),
+	}
+	invoke := chainRequestInterceptors(c.interceptors, frame.roundTrip)
+
+	var wg sync.WaitGroup
+	for i, item := range b.items {
+		wg.Add(1)
+		go func(slot int, item batchItem) {
+			defer wg.Done()
+			// An item that never reached the frame must not hold it back
+			defer frame.leave(slot, nil)
+			results[slot] = item.run(context.WithValue(ctx, batchSlotKey{}, slot), invoke)
+		}(i, item)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// run sends the item through invoke and collects its result
+func (item batchItem) run(ctx context.Context, invoke RequestInvoker) BatchResult {
+	result := BatchResult{Method: item.method}
+	if item.check != nil {
+		if err := item.check(ctx); err != nil {
+			result.Err = err
+			return result
+		}
+	}
+
+	response, err := invoke(ctx, &Request{Method: item.method, Params: item.params})
+	switch {
+	case err != nil:
+		result.Err = err
+	case response == nil:
+		result.Err = fmt.Errorf("%w: interceptor returned no response for %s", ErrInvalidResponse, item.method)
+	case response.Error != nil:
+		result.Response = response
+		result.Err = newMCPError(response.Error)
+	default:
+		result.Response = response
+	}
+	return result
+}
+
+// batchSlotKey is the context key under which each item's interceptor chain
+// carries the item's index, so the frame can tell the items apart
+type batchSlotKey struct{}
+
+// batchFrame gathers the requests of a batch into one frame as they come
+// out of their interceptor chains
+type batchFrame struct {
+	client *Client
+	sender transport.BatchSender
+
+	mu       sync.Mutex
+	joined   []bool // Items that joined the frame, or finished without doing so
+	waiting  int    // Items yet to join or finish
+	messages []*mcp.Message
+	sent     chan struct{} // Closed once the frame was sent or failed to be
+	err      error         // Why sending failed; valid once sent is closed
+}
+
+// roundTrip is the invoker at the end of every item's interceptor chain. It
+// adds the request to the frame, which is sent once no more items are
+// expected, and waits for the response. Requests that can't join the frame,
+// such as retries after it was sent, are sent on their own.
+func (f *batchFrame) roundTrip(ctx context.Context, req *Request) (*mcp.Message, error) {
+	slot, ok := ctx.Value(batchSlotKey{}).(int)
+	f.mu.Lock()
+	joined := !ok || f.joined[slot]
+	f.mu.Unlock()
+	if joined {
+		return f.client.roundTrip(ctx, req)
+	}
+
+	call, err := f.client.startCall(req)
+	if err != nil {
+		return nil, err
+	}
+	defer f.client.endCall(call)
+
+	if !f.leave(slot, call.request) {
+		// Another call for the item, made concurrently, joined first
+		if err := f.client.transport.Send(call.request); err != nil {
+			return nil, f.client.sendFailed("failed to send request", err)
+		}
+		return f.client.await(ctx, call)
+	}
+	select {
+	case <-f.sent:
+	case <-ctx.Done():
+		// Don't wait for slower items: keep the request out of the frame if
+		// it wasn't sent yet, and otherwise tell the server to drop it
+		if !f.withdraw(call.request) {
+			f.client.cancelRequest(call.id, call.method, "request cancelled by client")
+		}
+		return nil, ctx.Err()
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.client.await(ctx, call)
+}
+
+// withdraw removes a message from the frame, reporting false if the frame
+// was already being sent
+func (f *batchFrame) withdraw(message *mcp.Message) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.waiting == 0 {
+		return false
+	}
+	for i, m := range f.messages {
+		if m == message {
+			f.messages = append(f.messages[:i], f.messages[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// leave marks an item as done with the frame, adding its message if it has
+// one, and sends the frame once no more items are expected. It reports
+// false if the item was already done.
+func (f *batchFrame) leave(slot int, message *mcp.Message) bool {
+	f.mu.Lock()
+	if f.joined[slot] {
+		f.mu.Unlock()
+		return false
+	}
+	f.joined[slot] = true
+	if message != nil {
+		f.messages = append(f.messages, message)
+	}
+	f.waiting--
+	if f.waiting > 0 {
+		f.mu.Unlock()
+		return true
+	}
+	messages := f.messages
+	f.mu.Unlock()
+
+	defer close(f.sent)
+	if len(messages) > 0 {
+		f.client.log().Debug("sending batch", "requests", len(messages))
+		if err := f.sender.SendBatch(messages); err != nil {
+			f.err = f.client.sendFailed("failed to send batch", err)
+		}
+	}
+	return true
+}
@@ -1,9 +1,12 @@
 package client
 
 import (
-	"log"
+	"log/slog"
 	"time"
 
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/metrics"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/tracing"
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
 )
 
@@ -61,7 +64,7 @@ func (b *ClientBuilder) WithVersion(version string) *ClientBuilder {
 }
 
 // WithLogger sets the logger
-func (b *ClientBuilder) WithLogger(logger *log.Logger) *ClientBuilder {
+func (b *ClientBuilder) WithLogger(logger *slog.Logger) *ClientBuilder {
 	b.config.Logger = logger
 	return b
 }
@@ -72,6 +75,107 @@ func (b *ClientBuilder) WithTimeout(timeout time.Duration) *ClientBuilder {
 	return b
 }
 
+// WithSamplingHandler sets the handler for sampling/createMessage requests
+// and advertises the sampling capability to the server
+func (b *ClientBuilder) WithSamplingHandler(handler SamplingHandler) *ClientBuilder {
+	b.config.SamplingHandler = handler
+	return b
+}
+
+// WithMaxPages limits how many pages the list methods fetch
+func (b *ClientBuilder) WithMaxPages(maxPages int) *ClientBuilder {
+	b.config.MaxPages = maxPages
+	return b
+}
+
+// WithKeepAlive enables periodic pings to monitor connection health.
+// The connection is considered dead and closed after failureThreshold
+// consecutive failures, and restored if reconnection is enabled.
+func (b *ClientBuilder) WithKeepAlive(interval time.Duration, failureThreshold int) *ClientBuilder {
+	b.config.KeepAlive = KeepAliveConfig{
+		Interval:         interval,
+		FailureThreshold: failureThreshold,
+	}
+	return b
+}
+
+// WithReconnect enables automatic reconnection with exponential backoff between
+// initialDelay and maxDelay. A negative maxAttempts retries forever.
+func (b *ClientBuilder) WithReconnect(maxAttempts int, initialDelay, maxDelay time.Duration) *ClientBuilder {
+	b.config.Reconnect = ReconnectConfig{
+		MaxAttempts:  maxAttempts,
+		InitialDelay: initialDelay,
+		MaxDelay:     maxDelay,
+	}
+	return b
+}
+
+// WithElicitationHandler sets the handler for elicitation/create requests
+// and advertises the elicitation capability to the server
+func (b *ClientBuilder) WithElicitationHandler(handler ElicitationHandler) *ClientBuilder {
+	b.config.ElicitationHandler = handler
+	return b
+}
+
+// WithRoots sets the roots exposed to the server and advertises the roots capability
+func (b *ClientBuilder) WithRoots(roots ...mcp.Root) *ClientBuilder {
+	b.config.Roots = append([]mcp.Root{}, roots...)
+	return b
+}
+
+// WithProtocolVersion sets the protocol version requested during initialization
+func (b *ClientBuilder) WithProtocolVersion(version string) *ClientBuilder {
+	b.config.ProtocolVersion = version
+	return b
+}
+
+// WithLogSink routes server log entries (notifications/message) to sink
+func (b *ClientBuilder) WithLogSink(sink LogSink) *ClientBuilder {
+	b.config.LogSink = sink
+	return b
+}
+
+// WithoutArgumentValidation sends CallTool arguments without checking them
+// against the tool's input schema
+func (b *ClientBuilder) WithoutArgumentValidation() *ClientBuilder {
+	b.config.DisableArgumentValidation = true
+	return b
+}
+
+// WithCatalogCache caches the server's tools, resources and prompts,
+// reloading them after list_changed notifications
+func (b *ClientBuilder) WithCatalogCache() *ClientBuilder {
+	b.config.CacheCatalog = true
+	return b
+}
+
+// WithRequestInterceptor appends interceptors that wrap every request.
+// Interceptors run in the order they were added.
+func (b *ClientBuilder) WithRequestInterceptor(interceptors ...RequestInterceptor) *ClientBuilder {
+	b.config.RequestInterceptors = append(b.config.RequestInterceptors, interceptors...)
+	return b
+}
+
+// WithNotificationInterceptor appends interceptors that wrap the delivery of
+// incoming notifications. Interceptors run in the order they were added.
+func (b *ClientBuilder) WithNotificationInterceptor(interceptors ...NotificationInterceptor) *ClientBuilder {
+	b.config.NotificationInterceptors = append(b.config.NotificationInterceptors, interceptors...)
+	return b
+}
+
+// WithTracer opens a span for every request, exported by tracer, and
+// propagates its trace context to the server
+func (b *ClientBuilder) WithTracer(tracer *tracing.Tracer) *ClientBuilder {
+	b.config.Tracer = tracer
+	return b
+}
+
+// WithMetrics records request and connection metrics in registry, see ClientConfig.Metrics
+func (b *ClientBuilder) WithMetrics(registry *metrics.Registry) *ClientBuilder {
+	b.config.Metrics = registry
+	return b
+}
+
 // Build creates the MCP client
 func (b *ClientBuilder) Build() *Client {
 	if b.transport == nil {
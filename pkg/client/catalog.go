@@ -0,0 +1,324 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// CatalogDiff describes how a server's list of tools, resources or prompts
+// changed between two fetches. Items are matched by name (URI for resources).
+type CatalogDiff[T any] struct {
+	Added   []T
+	Removed []T
+	Changed []T // New versions of items whose definition changed
+}
+
+// Empty reports whether nothing changed
+func (d CatalogDiff[T]) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// catalogHandler receives the changes to one list
+type catalogHandler[T any] func(diff CatalogDiff[T])
+
+// catalogList caches one of the server's lists. It is loaded lazily,
+// invalidated by list_changed notifications and diffed on every reload.
+type catalogList[T any] struct {
+	key   func(T) string
+	fetch func(ctx context.Context) ([]T, error)
+
+	mu         sync.Mutex
+	items      []T
+	loaded     bool   // items holds a previous fetch that changes can be diffed against
+	valid      bool   // items are current and may be served from the cache
+	generation uint64 // Bumped by invalidate, so a fetch that raced a list_changed isn't trusted
+	handlers   []*catalogHandler[T]
+
+	fetchMu sync.Mutex // Serializes fetches so concurrent lookups share one
+}
+
+// get returns the cached items, fetching them if the cache is not valid
+func (l *catalogList[T]) get(ctx context.Context) ([]T, error) {
+	l.mu.Lock()
+	if l.valid {
+		items := append([]T(nil), l.items...)
+		l.mu.Unlock()
+		return items, nil
+	}
+	l.mu.Unlock()
+
+	l.fetchMu.Lock()
+	defer l.fetchMu.Unlock()
+
+	// Another caller may have fetched while we waited
+	l.mu.Lock()
+	if l.valid {
+		items := append([]T(nil), l.items...)
+		l.mu.Unlock()
+		return items, nil
+	}
+	generation := l.generation
+	l.mu.Unlock()
+
+	items, err := l.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l.store(items, generation)
+	return append([]T(nil), items...), nil
+}
+
+// current returns the generation to pass to store for a fetch starting now
+func (l *catalogList[T]) current() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.generation
+}
+
+// store caches freshly fetched items and notifies subscribers of changes.
+// The items are only trusted if nothing invalidated the list since generation.
+func (l *catalogList[T]) store(items []T, generation uint64) {
+	l.mu.Lock()
+	var diff CatalogDiff[T]
+	if l.loaded {
+		diff = diffCatalog(l.items, items, l.key)
+	}
+	l.items = append([]T(nil), items...)
+	l.loaded = true
+	l.valid = generation == l.generation
+	handlers := append([]*catalogHandler[T](nil), l.handlers...)
+	l.mu.Unlock()
+
+	if diff.Empty() {
+		return
+	}
+	for _, handler := range handlers {
+		(*handler)(diff)
+	}
+}
+
+// invalidate marks the cached items as stale; they are kept to diff the next fetch against
+func (l *catalogList[T]) invalidate() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.valid = false
+	l.generation++
+}
+
+// clear forgets the cached items, e.g. when disconnecting from a server
+func (l *catalogList[T]) clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.items = nil
+	l.loaded = false
+	l.valid = false
+	l.generation++
+}
+
+// subscribe registers a handler for changes and returns a function that removes it
+func (l *catalogList[T]) subscribe(handler func(CatalogDiff[T])) func() {
+	h := (*catalogHandler[T])(&handler)
+
+	l.mu.Lock()
+	l.handlers = append(l.handlers, h)
+	l.mu.Unlock()
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		for i, existing := range l.handlers {
+			if existing == h {
+				l.handlers = append(l.handlers[:i:i], l.handlers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// hasSubscribers reports whether anyone is waiting for change diffs
+func (l *catalogList[T]) hasSubscribers() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.handlers) > 0
+}
+
+// diffCatalog compares two versions of a list by key
+func diffCatalog[T any](previous, current []T, key func(T) string) CatalogDiff[T] {
+	var diff CatalogDiff[T]
+
+	before := make(map[string]T, len(previous))
+	for _, item := range previous {
+		before[key(item)] = item
+	}
+
+	for _, item := range current {
+		old, existed := before[key(item)]
+		delete(before, key(item))
+		switch {
+		case !existed:
+			diff.Added = append(diff.Added, item)
+		case !reflect.DeepEqual(old, item):
+			diff.Changed = append(diff.Changed, item)
+		}
+	}
+
+	// Keep removals in the server's previous order
+	for _, item := range previous {
+		if _, removed := before[key(item)]; removed {
+			diff.Removed = append(diff.Removed, item)
+		}
+	}
+	return diff
+}
+
+// catalog caches the server's tools, resources and prompts
+type catalog struct {
+	enabled   bool
+	tools     catalogList[mcp.Tool]
+	resources catalogList[mcp.Resource]
+	prompts   catalogList[mcp.Prompt]
+}
+
+// init wires the lists to the client's list methods
+func (cat *catalog) init(c *Client, enabled bool) {
+	cat.enabled = enabled
+
+	cat.tools.key = func(tool mcp.Tool) string { return tool.Name }
+	cat.tools.fetch = c.fetchTools
+	cat.resources.key = func(resource mcp.Resource) string { return resource.URI }
+	cat.resources.fetch = c.fetchResources
+	cat.prompts.key = func(prompt mcp.Prompt) string { return prompt.Name }
+	cat.prompts.fetch = c.fetchPrompts
+}
+
+// invalidate marks every list as stale, e.g. after re-initializing
+func (cat *catalog) invalidate() {
+	cat.tools.invalidate()
+	cat.resources.invalidate()
+	cat.prompts.invalidate()
+}
+
+// clear forgets every list
+func (cat *catalog) clear() {
+	cat.tools.clear()
+	cat.resources.clear()
+	cat.prompts.clear()
+}
+
+// Tools returns the server's tools. With CacheCatalog enabled the list is
+// served from the cache, which is reloaded after tools/list_changed;
+// otherwise it is fetched from the server like ListTools.
+func (c *Client) Tools(ctx context.Context) ([]mcp.Tool, error) {
+	if !c.catalog.enabled {
+		return c.ListTools(ctx)
+	}
+	return c.catalog.tools.get(ctx)
+}
+
+// Tool looks up a tool by name, see Tools
+func (c *Client) Tool(ctx context.Context, name string) (*mcp.Tool, error) {
+	tools, err := c.Tools(ctx)
+	return findItem(tools, err, c.catalog.tools.key, "tool", name)
+}
+
+// Resources returns the server's resources, cached like Tools
+func (c *Client) Resources(ctx context.Context) ([]mcp.Resource, error) {
+	if !c.catalog.enabled {
+		return c.ListResources(ctx)
+	}
+	return c.catalog.resources.get(ctx)
+}
+
+// Resource looks up a resource by URI, see Resources
+func (c *Client) Resource(ctx context.Context, uri string) (*mcp.Resource, error) {
+	resources, err := c.Resources(ctx)
+	return findItem(resources, err, c.catalog.resources.key, "resource", uri)
+}
+
+// Prompts returns the server's prompts, cached like Tools
+func (c *Client) Prompts(ctx context.Context) ([]mcp.Prompt, error) {
+	if !c.catalog.enabled {
+		return c.ListPrompts(ctx)
+	}
+	return c.catalog.prompts.get(ctx)
+}
+
+// Prompt looks up a prompt by name, see Prompts
+func (c *Client) Prompt(ctx context.Context, name string) (*mcp.Prompt, error) {
+	prompts, err := c.Prompts(ctx)
+	return findItem(prompts, err, c.catalog.prompts.key, "prompt", name)
+}
+
+// OnToolsChanged registers a handler for changes to the cached tool list.
+// Changes are detected when the cache is reloaded after tools/list_changed or
+// by ListTools; handlers are only called with CacheCatalog enabled.
+// It returns a function that removes the handler.
+func (c *Client) OnToolsChanged(handler func(CatalogDiff[mcp.Tool])) func() {
+	return c.catalog.tools.subscribe(handler)
+}
+
+// OnResourcesChanged registers a handler for changes to the cached resource list, see OnToolsChanged
+func (c *Client) OnResourcesChanged(handler func(CatalogDiff[mcp.Resource])) func() {
+	return c.catalog.resources.subscribe(handler)
+}
+
+// OnPromptsChanged registers a handler for changes to the cached prompt list, see OnToolsChanged
+func (c *Client) OnPromptsChanged(handler func(CatalogDiff[mcp.Prompt])) func() {
+	return c.catalog.prompts.subscribe(handler)
+}
+
+// routeListChanged invalidates the cached list named by a list_changed
+// notification. Tool schemas are forgotten even without CacheCatalog, so
+// calls aren't checked against schemas the server may have changed.
+func (c *Client) routeListChanged(notification *mcp.Message) {
+	if notification.Method == mcp.NotificationToolListChanged {
+		c.toolSchemas.clear()
+	}
+	if !c.catalog.enabled {
+		return
+	}
+
+	switch notification.Method {
+	case mcp.NotificationToolListChanged:
+		invalidateCatalogList(c, &c.catalog.tools, notification.Method)
+	case mcp.NotificationResourceListChanged:
+		invalidateCatalogList(c, &c.catalog.resources, notification.Method)
+	case mcp.NotificationPromptListChanged:
+		invalidateCatalogList(c, &c.catalog.prompts, notification.Method)
+	}
+}
+
+// invalidateCatalogList marks a list as stale and, if anyone is waiting for
+// diffs, reloads it right away; otherwise the next lookup reloads it
+func invalidateCatalogList[T any](c *Client, list *catalogList[T], method string) {
+	list.invalidate()
+	if !list.hasSubscribers() {
+		return
+	}
+
+	// Reloading waits on a response, which would deadlock the reader
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		defer cancel()
+		if _, err := list.get(ctx); err != nil {
+			c.log().Warn("failed to reload catalog", "method", method, "error", err)
+		}
+	}()
+}
+
+// findItem returns the item of a list result with the given key
+func findItem[T any](items []T, err error, key func(T) string, kind, want string) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		if key(items[i]) == want {
+			return &items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s %q: %w", kind, want, ErrNotFound)
+}
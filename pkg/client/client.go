@@ -28,19 +28,26 @@
 //	}
 //
 // The client is thread-safe and can be used concurrently from multiple goroutines.
+// A single background goroutine reads from the transport and routes each response
+// to the request waiting for it, so concurrent calls can share one connection.
 package client
 
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/metrics"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/tracing"
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
 )
 
@@ -53,18 +60,132 @@ type Client struct {
 	initialized        bool
 	mu                 sync.RWMutex
 	requestID          int64
-	logger             *log.Logger
+	logger             atomic.Pointer[slog.Logger] // baseLogger, with the server attribute once initialized
+	baseLogger         *slog.Logger                // Configured logger with the transport attribute
 	timeout            time.Duration
-	debug              bool // Enable debug logging
+	samplingHandler    SamplingHandler
+	elicitationHandler ElicitationHandler
+	roots              []mcp.Root
+	rootsEnabled       bool // Roots capability advertised to the server
+	notifications      notificationRegistry
+	subscriptions      resourceSubscriptions
+	progress           progressTracker
+	toolSchemas        toolSchemaCache
+	validateArguments  bool
+	catalog            catalog
+	keepAliveConfig    KeepAliveConfig
+	reconnectConfig    ReconnectConfig
+	maxPages           int
+	requestedVersion   string // Protocol version sent in initialize
+	protocolVersion    string // Protocol version negotiated with the server; guarded by mu
+	health             healthMonitor
+	events             connectionEvents
+	clientInfo         *mcp.ClientInfo      // From the last successful Initialize, reused when reconnecting
+	reconnecting       *reconnectRun        // Active reconnect loop, nil otherwise; guarded by mu
+	stopKeepAlive      context.CancelFunc   // Stops the current session's keepalive loop; guarded by mu
+	interceptors       []RequestInterceptor // Tracing, metrics and configured interceptors, in order
+	invoke             RequestInvoker       // roundTrip through the request interceptors
+	notify             NotificationInvoker  // dispatchNotification through the notification interceptors
+
+	reader    *readLoop                   // Current background reader, nil when disconnected
+	pending   map[int64]chan *mcp.Message // Requests awaiting a response, by ID
+	cancelled map[int64]struct{}          // Abandoned requests whose late responses are dropped
+	pendingMu sync.Mutex
+}
+
+// maxCancelledRequests bounds how many abandoned request IDs are remembered,
+// since servers are not required to answer a cancelled request at all
+const maxCancelledRequests = 1024
+
+// readLoop tracks one run of the background reader started by Connect
+type readLoop struct {
+	ctx    context.Context // Cancelled when the reader exits; bounds server-initiated requests
+	cancel context.CancelFunc
+	done   chan struct{} // Closed when the reader exits
+	err    error         // Why the reader exited; valid once done is closed
 }
 
 // ClientConfig holds configuration for the MCP client
 type ClientConfig struct {
 	Name    string
 	Version string
-	Logger  *log.Logger
 	Timeout time.Duration
-	Debug   bool // Enable debug logging for troubleshooting
+
+	// Logger receives the client's logs, with transport, server, method and
+	// request_id attributes where they apply. Message payloads and other
+	// troubleshooting details are logged at slog.LevelDebug, so they only
+	// appear if the logger's handler enables that level. If set, Logger is
+	// also passed to transports that accept one (see transport.TCPTransport.SetLogger).
+	// If nil, the client does not log.
+	Logger *slog.Logger
+
+	// Deprecated: Debug logging is controlled by Logger's level. If Logger
+	// is nil, Debug makes the client log to stderr at slog.LevelDebug.
+	Debug bool
+
+	// SamplingHandler answers sampling/createMessage requests from the server.
+	// The sampling capability is only advertised when a handler is set.
+	SamplingHandler SamplingHandler
+
+	// ElicitationHandler answers elicitation/create requests from the server.
+	// The elicitation capability is only advertised when a handler is set.
+	ElicitationHandler ElicitationHandler
+
+	// MaxPages limits how many pages ListTools, ListResources, ListPrompts and
+	// the iterators will fetch, guarding against servers that never stop
+	// returning cursors. Defaults to 100.
+	MaxPages int
+
+	// KeepAlive enables periodic pings after Initialize to monitor connection
+	// health (see Client.Health and Client.OnHealthChange)
+	KeepAlive KeepAliveConfig
+
+	// Reconnect enables automatic reconnection and re-initialization after
+	// the connection is lost (see ReconnectConfig and Client.OnConnectionEvent)
+	Reconnect ReconnectConfig
+
+	// Roots are the directories the server may operate on, answered on roots/list.
+	// The roots capability is only advertised when Roots is non-nil; use an empty
+	// slice to advertise support and add roots later with SetRoots or AddRoot.
+	Roots []mcp.Root
+
+	// ProtocolVersion is the protocol version requested during initialization.
+	// It must be one of mcp.SupportedProtocolVersions; defaults to
+	// mcp.LatestProtocolVersion. The server may answer with an older
+	// supported version, see Client.ProtocolVersion.
+	ProtocolVersion string
+
+	// LogSink receives the log entries the server sends in notifications/message.
+	// Use Client.SetLogLevel to choose which entries the server sends.
+	LogSink LogSink
+
+	// DisableArgumentValidation sends CallTool arguments without checking them
+	// against the tool's input schema, for servers whose schemas don't match
+	// what their tools accept
+	DisableArgumentValidation bool
+
+	// CacheCatalog caches the server's tools, resources and prompts for Tools,
+	// Tool, Resources and the other catalog lookups. A cached list is reloaded
+	// after the server's list_changed notification for it.
+	CacheCatalog bool
+
+	// RequestInterceptors wrap every request sent to the server, in order
+	// (see RequestInterceptor)
+	RequestInterceptors []RequestInterceptor
+
+	// NotificationInterceptors wrap the delivery of every notification
+	// received from the server, in order (see NotificationInterceptor)
+	NotificationInterceptors []NotificationInterceptor
+
+	// Tracer opens a span for every request and propagates its W3C trace
+	// context to the server (see TracingInterceptor). It runs before the
+	// RequestInterceptors, so retries they make share one span.
+	Tracer *tracing.Tracer
+
+	// Metrics records request counts, errors, latencies, in-flight requests
+	// and connection events in a registry, labelled with Name and the
+	// transport type. Transports that support it count their messages there too.
+	Metrics *metrics.Registry
 }
 
 // NewClient creates a new MCP client with the given transport and configuration.
@@ -72,7 +193,7 @@ type ClientConfig struct {
 // The transport parameter specifies how to communicate with the MCP server (TCP, STDIO, etc.).
 // The config parameter allows customization of client behavior including logging and timeouts.
 //
-// If config.Logger is nil, log.Default() will be used.
+// If config.Logger is nil, nothing is logged.
 // If config.Timeout is 0, a default timeout of 30 seconds will be used.
 //
 // Example:
@@ -81,51 +202,99 @@ type ClientConfig struct {
 //	config := ClientConfig{
 //		Name:    "my-app",
 //		Version: "1.0.0",
-//		Logger:  myLogger,
+//		Logger:  slog.Default(),
 //		Timeout: 60 * time.Second,
 //	}
 //	client := NewClient(transport, config)
 func NewClient(transport transport.Transport, config ClientConfig) *Client {
+	explicitLogger := config.Logger != nil || config.Debug
 	if config.Logger == nil {
-		config.Logger = log.Default()
+		config.Logger = slog.New(discardHandler{})
+		if config.Debug {
+			config.Logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		}
 	}
 	if config.Timeout == 0 {
 		config.Timeout = 30 * time.Second
 	}
+	if config.ProtocolVersion == "" {
+		config.ProtocolVersion = mcp.LatestProtocolVersion
+	}
+	if config.MaxPages <= 0 {
+		config.MaxPages = 100
+	}
+	if config.KeepAlive.Interval > 0 {
+		if config.KeepAlive.Timeout == 0 {
+			config.KeepAlive.Timeout = config.KeepAlive.Interval
+		}
+		if config.KeepAlive.FailureThreshold <= 0 {
+			config.KeepAlive.FailureThreshold = 3
+		}
+	}
 
 	client := &Client{
-		transport: transport,
-		logger:    config.Logger,
-		timeout:   config.Timeout,
-		debug:     config.Debug,
+		transport:  transport,
+		baseLogger: config.Logger.With("transport", transportType(transport)),
+		timeout:    config.Timeout,
+		pending:    make(map[int64]chan *mcp.Message),
+		cancelled:  make(map[int64]struct{}),
+
+		samplingHandler:    config.SamplingHandler,
+		elicitationHandler: config.ElicitationHandler,
+		roots:              append([]mcp.Root(nil), config.Roots...),
+		rootsEnabled:       config.Roots != nil,
+		keepAliveConfig:    config.KeepAlive,
+		reconnectConfig:    config.Reconnect.withDefaults(),
+		maxPages:           config.MaxPages,
+		requestedVersion:   config.ProtocolVersion,
+		validateArguments:  !config.DisableArgumentValidation,
+	}
+
+	client.logger.Store(client.baseLogger)
+	client.catalog.init(client, config.CacheCatalog)
+	var interceptors []RequestInterceptor
+	if config.Tracer != nil {
+		interceptors = append(interceptors, TracingInterceptor(config.Tracer))
+	}
+	if config.Metrics != nil {
+		m := newClientMetrics(config.Metrics, config.Name, transportType(transport))
+		interceptors = append(interceptors, m.interceptor())
+		client.OnConnectionEvent(m.connectionEvent)
+
+		type instrumentable interface {
+			SetMetrics(*metrics.Registry)
+		}
+		if it, ok := transport.(instrumentable); ok {
+			it.SetMetrics(config.Metrics)
+		}
+	}
+	interceptors = append(interceptors, config.RequestInterceptors...)
+	client.interceptors = interceptors
+	client.invoke = chainRequestInterceptors(interceptors, client.roundTrip)
+	client.notify = chainNotificationInterceptors(config.NotificationInterceptors, client.dispatchNotification)
+
+	if config.LogSink != nil {
+		client.AddLogSink(config.LogSink)
 	}
 
-	// Enable debug mode on transport if it supports it
-	if config.Debug {
-		// Type assert to check if it's a TCPTransport
-		type debuggable interface {
-			SetDebug(bool)
+	// Hand an explicitly configured logger on to transports that log, so
+	// debug level also shows their message payloads
+	if explicitLogger {
+		type loggable interface {
+			SetLogger(*slog.Logger)
 		}
-		if dt, ok := transport.(debuggable); ok {
-			dt.SetDebug(true)
+		if lt, ok := transport.(loggable); ok {
+			lt.SetLogger(config.Logger)
 		}
 	}
 
 	return client
 }
 
-// logf logs a message with optional component prefix and debug flag
-// This is a helper for structured logging that respects the debug flag
-// Format: [component] message
-func (c *Client) logf(component string, format string, args ...interface{}) {
-	if !c.debug {
-		return
-	}
-	if component != "" {
-		c.logger.Printf("[%s] %s", component, fmt.Sprintf(format, args...))
-	} else {
-		c.logger.Printf(format, args...)
-	}
+// log returns the client's logger, which carries the transport attribute
+// and, once initialized, the server attribute
+func (c *Client) log() *slog.Logger {
+	return c.logger.Load()
 }
 
 // Connect establishes connection to the MCP server.
@@ -145,18 +314,15 @@ func (c *Client) Connect(ctx context.Context) error {
 		return nil
 	}
 
-	if c.debug {
-		c.logger.Println("Connecting to MCP server...")
-	}
+	c.log().Debug("connecting to MCP server")
 
 	if err := c.transport.Connect(ctx); err != nil {
-		return fmt.Errorf("failed to connect: %w", err)
+		return NewTransportError(transportType(c.transport), "failed to connect", err)
 	}
 
 	c.connected = true
-	if c.debug {
-		c.logger.Println("Connected to MCP server")
-	}
+	c.startReader()
+	c.log().Debug("connected to MCP server")
 	return nil
 }
 
@@ -173,39 +339,43 @@ func (c *Client) Initialize(ctx context.Context, clientInfo mcp.ClientInfo) erro
 	if !c.IsConnected() {
 		return ErrNotConnected
 	}
-
-	if c.debug {
-		c.logger.Printf("Initializing MCP protocol with client: %s %s", clientInfo.Name, clientInfo.Version)
-		c.logger.Printf("Creating initialize request...")
+	if !mcp.IsSupportedProtocolVersion(c.requestedVersion) {
+		return fmt.Errorf("%w: %q is not one of %s", ErrUnsupportedProtocolVersion,
+			c.requestedVersion, strings.Join(mcp.SupportedProtocolVersions, ", "))
 	}
 
 	// Create initialize request
-	request := mcp.InitializeRequest{
-		ProtocolVersion: mcp.Version,
-		Capabilities: mcp.ClientCapabilities{
-			Experimental: make(map[string]interface{}),
-			Sampling:     &mcp.SamplingCapability{},
-		},
-		ClientInfo: clientInfo,
+	capabilities := mcp.ClientCapabilities{
+		Experimental: make(map[string]interface{}),
+	}
+	if c.samplingHandler != nil {
+		capabilities.Sampling = &mcp.SamplingCapability{}
+	}
+	if c.rootsEnabled {
+		capabilities.Roots = &mcp.RootsCapability{ListChanged: true}
+	}
+	// Elicitation was introduced in 2025-06-18; older servers don't know the capability
+	if c.elicitationHandler != nil && mcp.ProtocolVersionAtLeast(c.requestedVersion, mcp.ProtocolVersion20250618) {
+		capabilities.Elicitation = &mcp.ElicitationCapability{}
 	}
 
-	if c.debug {
-		c.logger.Printf("Initialize request created successfully")
-		c.logger.Printf("Sending initialize request...")
+	request := mcp.InitializeRequest{
+		ProtocolVersion: c.requestedVersion,
+		Capabilities:    capabilities,
+		ClientInfo:      clientInfo,
 	}
 
+	c.log().Debug("initializing MCP session",
+		"client", clientInfo.Name, "client_version", clientInfo.Version, "protocol_version", c.requestedVersion)
+
 	// Send initialize request
 	response, err := c.sendRequest(ctx, "initialize", request)
 	if err != nil {
 		return fmt.Errorf("initialize request failed: %w", err)
 	}
 
-	if c.debug {
-		c.logger.Printf("Received initialize response")
-	}
-
 	if response.Error != nil {
-		return fmt.Errorf("initialize error: %s", response.Error.Message)
+		return fmt.Errorf("initialize error: %w", newMCPError(response.Error))
 	}
 
 	// Parse initialize response
@@ -214,48 +384,104 @@ func (c *Client) Initialize(ctx context.Context, clientInfo mcp.ClientInfo) erro
 		return fmt.Errorf("failed to parse initialize response: %w", err)
 	}
 
+	// The server answers with the requested version or another version it
+	// supports; fail cleanly if we can't speak it
+	if !mcp.IsSupportedProtocolVersion(initResponse.ProtocolVersion) {
+		return fmt.Errorf("%w: server chose %q, client supports %s", ErrUnsupportedProtocolVersion,
+			initResponse.ProtocolVersion, strings.Join(mcp.SupportedProtocolVersions, ", "))
+	}
+	if initResponse.ProtocolVersion != c.requestedVersion {
+		c.log().Debug("server negotiated a different protocol version",
+			"protocol_version", initResponse.ProtocolVersion, "requested", c.requestedVersion)
+	}
+
+	// HTTP transports must send the negotiated version with every request from 2025-06-18
+	if mcp.ProtocolVersionAtLeast(initResponse.ProtocolVersion, mcp.ProtocolVersion20250618) {
+		type versioned interface {
+			SetProtocolVersion(string)
+		}
+		if vt, ok := c.transport.(versioned); ok {
+			vt.SetProtocolVersion(initResponse.ProtocolVersion)
+		}
+	}
+
 	c.mu.Lock()
 	c.serverInfo = &initResponse.ServerInfo
 	c.serverCapabilities = &initResponse.Capabilities
+	c.protocolVersion = initResponse.ProtocolVersion
+	c.clientInfo = &clientInfo
 	c.initialized = true
+	reader := c.reader
 	c.mu.Unlock()
+	c.logger.Store(c.baseLogger.With("server", initResponse.ServerInfo.Name))
 
-	if c.debug {
-		c.logger.Printf("MCP protocol initialized. Server: %s %s",
-			initResponse.ServerInfo.Name, initResponse.ServerInfo.Version)
-	}
+	// The lists may have changed while we were disconnected
+	c.catalog.invalidate()
+
+	c.health.set(HealthHealthy)
+	c.mu.Lock()
+	c.startKeepAlive(reader)
+	c.mu.Unlock()
+
+	c.log().Debug("MCP session initialized",
+		"server_version", initResponse.ServerInfo.Version, "protocol_version", initResponse.ProtocolVersion)
 
 	// Send initialized notification
-	notification := mcp.NewNotification("notifications/initialized", nil)
+	notification := mcp.NewNotification(mcp.NotificationInitialized, nil)
 	if err := c.transport.Send(notification); err != nil {
 		return fmt.Errorf("failed to send initialized notification: %w", err)
 	}
 
+	// Restore resource subscriptions from before a reconnect
+	c.resubscribeResources(ctx)
+
 	return nil
 }
 
-// Disconnect closes the connection to the MCP server
+// Disconnect closes the connection to the MCP server and stops any
+// reconnect attempts in progress
 func (c *Client) Disconnect() error {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	if c.reconnecting != nil {
+		c.reconnecting.cancel()
+		c.reconnecting = nil
+	}
+	c.clientInfo = nil
+	c.mu.Unlock()
+
+	return c.disconnect()
+}
+
+// disconnect closes the connection and resets the session state
+func (c *Client) disconnect() error {
+	c.mu.Lock()
 
 	if !c.connected {
+		c.mu.Unlock()
 		return nil
 	}
 
-	if c.debug {
-		c.logger.Println("Disconnecting from MCP server...")
-	}
+	c.log().Debug("disconnecting from MCP server")
 
+	// Closing the transport unblocks the reader, which then fails any
+	// requests still waiting for a response
 	err := c.transport.Close()
+	c.reader = nil
 	c.connected = false
 	c.initialized = false
 	c.serverInfo = nil
 	c.serverCapabilities = nil
+	c.protocolVersion = ""
+	c.mu.Unlock()
 
-	if c.debug {
-		c.logger.Println("Disconnected from MCP server")
-	}
+	c.toolSchemas.clear()
+	c.catalog.clear()
+
+	// Health handlers may call back into the client, so run them unlocked
+	c.health.set(HealthUnknown)
+
+	c.log().Debug("disconnected from MCP server")
+	c.logger.Store(c.baseLogger)
 	return err
 }
 
@@ -284,6 +510,19 @@ func (c *Client) GetServerInfo() *mcp.ServerInfo {
 	return &info
 }
 
+// ProtocolVersion returns the protocol version negotiated during Initialize,
+// or "" if the client is not initialized
+func (c *Client) ProtocolVersion() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.protocolVersion
+}
+
+// protocolAtLeast reports whether the negotiated protocol version is minimum or newer
+func (c *Client) protocolAtLeast(minimum string) bool {
+	return mcp.ProtocolVersionAtLeast(c.ProtocolVersion(), minimum)
+}
+
 // GetServerCapabilities returns the server's capabilities
 func (c *Client) GetServerCapabilities() *mcp.ServerCapabilities {
 	c.mu.RLock()
@@ -295,21 +534,44 @@ func (c *Client) GetServerCapabilities() *mcp.ServerCapabilities {
 	return &caps
 }
 
-// ListTools retrieves all available tools from the server
-// Per MCP spec, this handles pagination automatically by fetching all pages
+// ListTools retrieves all available tools from the server.
+// Per MCP spec, this handles pagination automatically by fetching all pages,
+// up to the configured MaxPages.
+//
+// With CacheCatalog enabled the result also refreshes the cached catalog (see Tools).
 func (c *Client) ListTools(ctx context.Context) ([]mcp.Tool, error) {
-	return c.ListToolsWithCursor(ctx, "")
+	generation := c.catalog.tools.current()
+	tools, err := c.fetchTools(ctx)
+	if err == nil && c.catalog.enabled {
+		c.catalog.tools.store(tools, generation)
+	}
+	return tools, err
+}
+
+// fetchTools retrieves every page of tools, replacing the remembered tool schemas
+func (c *Client) fetchTools(ctx context.Context) ([]mcp.Tool, error) {
+	tools, err := collectAll(c.ToolsIterator(ctx))
+	if err == nil {
+		c.toolSchemas.replace(tools)
+	}
+	return tools, err
 }
 
-// ListToolsWithCursor retrieves tools from the server with optional pagination cursor
-// This is MCP 2025-11-25 compliant and supports server-side pagination
+// ListToolsWithCursor retrieves a single page of tools starting at cursor.
+// Use ListToolsPage to also get the cursor for the following page.
 func (c *Client) ListToolsWithCursor(ctx context.Context, cursor string) ([]mcp.Tool, error) {
-	if !c.IsInitialized() {
-		return nil, fmt.Errorf("client not initialized")
+	page, err := c.ListToolsPage(ctx, cursor)
+	if err != nil {
+		return nil, err
 	}
+	return page.Tools, nil
+}
 
-	if c.debug {
-		c.logger.Println("Listing available tools...")
+// ListToolsPage retrieves one page of tools from the server.
+// An empty cursor requests the first page; page.NextCursor is empty on the last page.
+func (c *Client) ListToolsPage(ctx context.Context, cursor string) (*mcp.ListToolsResponse, error) {
+	if !c.IsInitialized() {
+		return nil, ErrNotInitialized
 	}
 
 	// Create request with optional cursor for pagination (MCP spec compliant)
@@ -322,39 +584,33 @@ func (c *Client) ListToolsWithCursor(ctx context.Context, cursor string) ([]mcp.
 		return nil, fmt.Errorf("list tools request failed: %w", err)
 	}
 
-	if c.debug {
-		c.logger.Printf("DEBUG ListTools: Response ID=%v, Error=%v", response.ID, response.Error)
-		c.logger.Printf("DEBUG ListTools: Result type=%T, value=%+v", response.Result, response.Result)
-	}
-
 	if response.Error != nil {
-		return nil, fmt.Errorf("list tools error: %s", response.Error.Message)
+		return nil, fmt.Errorf("list tools error: %w", newMCPError(response.Error))
 	}
 
 	var listResponse mcp.ListToolsResponse
 	if err := c.parseResult(response.Result, &listResponse); err != nil {
-		c.logger.Printf("ERROR ListTools: Failed to parse result - %v", err)
-		c.logger.Printf("ERROR ListTools: Raw result was: %+v", response.Result)
 		return nil, fmt.Errorf("failed to parse list tools response: %w", err)
 	}
 
-	if c.debug {
-		c.logger.Printf("DEBUG ListTools: Parsed response - Tools count=%d, NextCursor=%s", len(listResponse.Tools), listResponse.NextCursor)
-		for i, tool := range listResponse.Tools {
-			c.logger.Printf("DEBUG ListTools: Tool[%d]: Name=%s, Title=%s, Description=%s", i, tool.Name, tool.Title, tool.Description)
-		}
-	}
+	c.toolSchemas.remember(listResponse.Tools)
 
-	if c.debug {
-		c.logger.Printf("Found %d tools", len(listResponse.Tools))
-	}
-	return listResponse.Tools, nil
+	c.log().Debug("listed tools", "count", len(listResponse.Tools), "next_cursor", listResponse.NextCursor)
+	return &listResponse, nil
 }
 
-// CallTool executes a tool on the server
-func (c *Client) CallTool(ctx context.Context, name string, arguments map[string]interface{}) (*mcp.CallToolResponse, error) {
+// CallTool executes a tool on the server.
+//
+// Options such as WithProgress customize the individual call. If the tool's
+// input schema is known (from ListTools or the cached catalog), arguments are
+// validated against it before sending, unless DisableArgumentValidation is set;
+// failures wrap a *jsonschema.ValidationError. If the tool's output schema is
+// known (from ListTools or WithOutputSchema), a successful result's
+// StructuredContent is validated against it. Schemas learned from ListTools
+// are forgotten when the server sends tools/list_changed.
+func (c *Client) CallTool(ctx context.Context, name string, arguments map[string]interface{}, opts ...CallToolOption) (*mcp.CallToolResponse, error) {
 	if !c.IsInitialized() {
-		return nil, fmt.Errorf("client not initialized")
+		return nil, ErrNotInitialized
 	}
 
 	// Check connection health before making the call
@@ -362,8 +618,13 @@ func (c *Client) CallTool(ctx context.Context, name string, arguments map[string
 		return nil, fmt.Errorf("connection check failed: %w", err)
 	}
 
-	if c.debug {
-		c.logger.Printf("Calling tool: %s", name)
+	var options callToolOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if err := c.validateToolArguments(ctx, name, arguments); err != nil {
+		return nil, err
 	}
 
 	request := mcp.CallToolRequest{
@@ -371,13 +632,19 @@ func (c *Client) CallTool(ctx context.Context, name string, arguments map[string
 		Arguments: arguments,
 	}
 
+	if options.progress != nil {
+		token, release := c.progress.register(options.progress)
+		defer release()
+		request.Meta = map[string]interface{}{"progressToken": token}
+	}
+
 	response, err := c.sendRequest(ctx, "tools/call", request)
 	if err != nil {
 		return nil, fmt.Errorf("call tool request failed: %w", err)
 	}
 
 	if response.Error != nil {
-		return nil, fmt.Errorf("call tool error: %s", response.Error.Message)
+		return nil, fmt.Errorf("call tool error: %w", newMCPError(response.Error))
 	}
 
 	var callResponse mcp.CallToolResponse
@@ -385,29 +652,44 @@ func (c *Client) CallTool(ctx context.Context, name string, arguments map[string
 		return nil, fmt.Errorf("failed to parse call tool response: %w", err)
 	}
 
-	if c.debug {
-		c.logger.Printf("Tool '%s' executed successfully", name)
+	if err := c.validateStructuredContent(name, &callResponse, options.outputSchema); err != nil {
+		return nil, err
 	}
+
+	c.log().Debug("tool call succeeded", "tool", name, "is_error", callResponse.IsError)
 	return &callResponse, nil
 }
 
-// ListResources retrieves all available resources from the server
+// ListResources retrieves all available resources from the server,
+// following pagination cursors up to the configured MaxPages
 func (c *Client) ListResources(ctx context.Context) ([]mcp.Resource, error) {
-	if !c.IsInitialized() {
-		return nil, fmt.Errorf("client not initialized")
+	generation := c.catalog.resources.current()
+	resources, err := c.fetchResources(ctx)
+	if err == nil && c.catalog.enabled {
+		c.catalog.resources.store(resources, generation)
 	}
+	return resources, err
+}
 
-	if c.debug {
-		c.logger.Println("Listing available resources...")
+// fetchResources retrieves every page of resources
+func (c *Client) fetchResources(ctx context.Context) ([]mcp.Resource, error) {
+	return collectAll(c.ResourcesIterator(ctx))
+}
+
+// ListResourcesPage retrieves one page of resources from the server.
+// An empty cursor requests the first page; page.NextCursor is empty on the last page.
+func (c *Client) ListResourcesPage(ctx context.Context, cursor string) (*mcp.ListResourcesResponse, error) {
+	if !c.IsInitialized() {
+		return nil, ErrNotInitialized
 	}
 
-	response, err := c.sendRequest(ctx, "resources/list", mcp.ListResourcesRequest{})
+	response, err := c.sendRequest(ctx, "resources/list", mcp.ListResourcesRequest{Cursor: cursor})
 	if err != nil {
 		return nil, fmt.Errorf("list resources request failed: %w", err)
 	}
 
 	if response.Error != nil {
-		return nil, fmt.Errorf("list resources error: %s", response.Error.Message)
+		return nil, fmt.Errorf("list resources error: %w", newMCPError(response.Error))
 	}
 
 	var listResponse mcp.ListResourcesResponse
@@ -415,29 +697,80 @@ func (c *Client) ListResources(ctx context.Context) ([]mcp.Resource, error) {
 		return nil, fmt.Errorf("failed to parse list resources response: %w", err)
 	}
 
-	if c.debug {
-		c.logger.Printf("Found %d resources", len(listResponse.Resources))
+	c.log().Debug("listed resources", "count", len(listResponse.Resources), "next_cursor", listResponse.NextCursor)
+	return &listResponse, nil
+}
+
+// ListResourceTemplates retrieves all resource templates from the server,
+// following pagination cursors up to the configured MaxPages
+func (c *Client) ListResourceTemplates(ctx context.Context) ([]mcp.ResourceTemplate, error) {
+	return collectAll(c.ResourceTemplatesIterator(ctx))
+}
+
+// ListResourceTemplatesPage retrieves one page of resource templates from the server.
+// An empty cursor requests the first page; page.NextCursor is empty on the last page.
+func (c *Client) ListResourceTemplatesPage(ctx context.Context, cursor string) (*mcp.ListResourceTemplatesResponse, error) {
+	if !c.IsInitialized() {
+		return nil, ErrNotInitialized
+	}
+
+	response, err := c.sendRequest(ctx, "resources/templates/list", mcp.ListResourceTemplatesRequest{Cursor: cursor})
+	if err != nil {
+		return nil, fmt.Errorf("list resource templates request failed: %w", err)
+	}
+
+	if response.Error != nil {
+		return nil, fmt.Errorf("list resource templates error: %w", newMCPError(response.Error))
+	}
+
+	var listResponse mcp.ListResourceTemplatesResponse
+	if err := c.parseResult(response.Result, &listResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse list resource templates response: %w", err)
+	}
+
+	c.log().Debug("listed resource templates", "count", len(listResponse.ResourceTemplates), "next_cursor", listResponse.NextCursor)
+	return &listResponse, nil
+}
+
+// ReadResourceTemplate expands a resource template with vars and reads the resulting resource
+func (c *Client) ReadResourceTemplate(ctx context.Context, template mcp.ResourceTemplate, vars map[string]interface{}) (*mcp.ReadResourceResponse, error) {
+	uri, err := template.Expand(vars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand resource template %q: %w", template.URITemplate, err)
 	}
-	return listResponse.Resources, nil
+	return c.ReadResource(ctx, uri)
 }
 
-// ListPrompts retrieves all available prompts from the server
+// ListPrompts retrieves all available prompts from the server,
+// following pagination cursors up to the configured MaxPages
 func (c *Client) ListPrompts(ctx context.Context) ([]mcp.Prompt, error) {
-	if !c.IsInitialized() {
-		return nil, fmt.Errorf("client not initialized")
+	generation := c.catalog.prompts.current()
+	prompts, err := c.fetchPrompts(ctx)
+	if err == nil && c.catalog.enabled {
+		c.catalog.prompts.store(prompts, generation)
 	}
+	return prompts, err
+}
+
+// fetchPrompts retrieves every page of prompts
+func (c *Client) fetchPrompts(ctx context.Context) ([]mcp.Prompt, error) {
+	return collectAll(c.PromptsIterator(ctx))
+}
 
-	if c.debug {
-		c.logger.Println("Listing available prompts...")
+// ListPromptsPage retrieves one page of prompts from the server.
+// An empty cursor requests the first page; page.NextCursor is empty on the last page.
+func (c *Client) ListPromptsPage(ctx context.Context, cursor string) (*mcp.ListPromptsResponse, error) {
+	if !c.IsInitialized() {
+		return nil, ErrNotInitialized
 	}
 
-	response, err := c.sendRequest(ctx, "prompts/list", mcp.ListPromptsRequest{})
+	response, err := c.sendRequest(ctx, "prompts/list", mcp.ListPromptsRequest{Cursor: cursor})
 	if err != nil {
 		return nil, fmt.Errorf("list prompts request failed: %w", err)
 	}
 
 	if response.Error != nil {
-		return nil, fmt.Errorf("list prompts error: %s", response.Error.Message)
+		return nil, fmt.Errorf("list prompts error: %w", newMCPError(response.Error))
 	}
 
 	var listResponse mcp.ListPromptsResponse
@@ -445,20 +778,14 @@ func (c *Client) ListPrompts(ctx context.Context) ([]mcp.Prompt, error) {
 		return nil, fmt.Errorf("failed to parse list prompts response: %w", err)
 	}
 
-	if c.debug {
-		c.logger.Printf("Found %d prompts", len(listResponse.Prompts))
-	}
-	return listResponse.Prompts, nil
+	c.log().Debug("listed prompts", "count", len(listResponse.Prompts), "next_cursor", listResponse.NextCursor)
+	return &listResponse, nil
 }
 
 // GetPrompt retrieves a specific prompt from the server with optional arguments
 func (c *Client) GetPrompt(ctx context.Context, name string, arguments map[string]interface{}) (*mcp.GetPromptResponse, error) {
 	if !c.IsInitialized() {
-		return nil, fmt.Errorf("client not initialized")
-	}
-
-	if c.debug {
-		c.logger.Printf("Getting prompt: %s", name)
+		return nil, ErrNotInitialized
 	}
 
 	request := mcp.GetPromptRequest{
@@ -472,7 +799,7 @@ func (c *Client) GetPrompt(ctx context.Context, name string, arguments map[strin
 	}
 
 	if response.Error != nil {
-		return nil, fmt.Errorf("get prompt error: %s", response.Error.Message)
+		return nil, fmt.Errorf("get prompt error: %w", newMCPError(response.Error))
 	}
 
 	var promptResponse mcp.GetPromptResponse
@@ -480,20 +807,14 @@ func (c *Client) GetPrompt(ctx context.Context, name string, arguments map[strin
 		return nil, fmt.Errorf("failed to parse get prompt response: %w", err)
 	}
 
-	if c.debug {
-		c.logger.Printf("Retrieved prompt '%s' with %d messages", name, len(promptResponse.Messages))
-	}
+	c.log().Debug("got prompt", "prompt", name, "messages", len(promptResponse.Messages))
 	return &promptResponse, nil
 }
 
 // ReadResource retrieves the content of a specific resource from the server
 func (c *Client) ReadResource(ctx context.Context, uri string) (*mcp.ReadResourceResponse, error) {
 	if !c.IsInitialized() {
-		return nil, fmt.Errorf("client not initialized")
-	}
-
-	if c.debug {
-		c.logger.Printf("Reading resource: %s", uri)
+		return nil, ErrNotInitialized
 	}
 
 	request := mcp.ReadResourceRequest{
@@ -506,7 +827,7 @@ func (c *Client) ReadResource(ctx context.Context, uri string) (*mcp.ReadResourc
 	}
 
 	if response.Error != nil {
-		return nil, fmt.Errorf("read resource error: %s", response.Error.Message)
+		return nil, fmt.Errorf("read resource error: %w", newMCPError(response.Error))
 	}
 
 	var resourceResponse mcp.ReadResourceResponse
@@ -514,80 +835,307 @@ func (c *Client) ReadResource(ctx context.Context, uri string) (*mcp.ReadResourc
 		return nil, fmt.Errorf("failed to parse read resource response: %w", err)
 	}
 
-	if c.debug {
-		c.logger.Printf("Read resource '%s' with %d content items", uri, len(resourceResponse.Contents))
-	}
+	c.log().Debug("read resource", "uri", uri, "contents", len(resourceResponse.Contents))
 	return &resourceResponse, nil
 }
 
-// sendRequest sends a request and waits for the response
+// sendRequest sends a request through the interceptor chain and returns the response
 func (c *Client) sendRequest(ctx context.Context, method string, params interface{}) (*mcp.Message, error) {
-	requestID := atomic.AddInt64(&c.requestID, 1)
+	response, err := c.invoke(ctx, &Request{Method: method, Params: params})
+	if err == nil && response == nil {
+		return nil, fmt.Errorf("%w: interceptor returned no response for %s", ErrInvalidResponse, method)
+	}
+	return response, err
+}
 
-	request := mcp.NewRequest(requestID, method, params)
+// roundTrip sends a request and waits for the background reader to
+// deliver the response with the matching ID
+func (c *Client) roundTrip(ctx context.Context, req *Request) (*mcp.Message, error) {
+	call, err := c.startCall(req)
+	if err != nil {
+		return nil, err
+	}
+	defer c.endCall(call)
+
+	if err := c.transport.Send(call.request); err != nil {
+		return nil, c.sendFailed("failed to send request", err)
+	}
+	return c.await(ctx, call)
+}
+
+// pendingCall is a request registered to receive its response
+type pendingCall struct {
+	request  *mcp.Message
+	method   string
+	id       int64
+	reader   *readLoop
+	response chan *mcp.Message
+}
+
+// startCall assigns the request its ID and registers it for the response.
+// It registers before sending so a fast response can't arrive unclaimed.
+func (c *Client) startCall(req *Request) (*pendingCall, error) {
+	c.mu.RLock()
+	reader := c.reader
+	c.mu.RUnlock()
 
 	// Check if transport is still connected before sending
-	if !c.transport.IsConnected() {
-		return nil, fmt.Errorf("transport disconnected")
+	if reader == nil || !c.transport.IsConnected() {
+		return nil, ErrNotConnected
 	}
 
-	if err := c.transport.Send(request); err != nil {
-		// Mark client as disconnected if send fails
-		c.mu.Lock()
-		c.connected = false
-		c.initialized = false
-		c.mu.Unlock()
-		return nil, fmt.Errorf("failed to send request: %w", err)
+	requestID := atomic.AddInt64(&c.requestID, 1)
+	req.ID = requestID
+
+	call := &pendingCall{
+		request:  mcp.NewRequest(requestID, req.Method, req.Params),
+		method:   req.Method,
+		id:       requestID,
+		reader:   reader,
+		response: make(chan *mcp.Message, 1),
 	}
+	c.pendingMu.Lock()
+	c.pending[requestID] = call.response
+	c.pendingMu.Unlock()
+
+	c.log().Debug("sending request", "method", req.Method, "request_id", requestID)
+	return call, nil
+}
+
+// endCall stops waiting for the call's response
+func (c *Client) endCall(call *pendingCall) {
+	c.pendingMu.Lock()
+	delete(c.pending, call.id)
+	c.pendingMu.Unlock()
+}
+
+// sendFailed abandons the connection after the transport failed to send and
+// returns the error to report
+func (c *Client) sendFailed(message string, err error) error {
+	c.mu.RLock()
+	reader := c.reader
+	c.mu.RUnlock()
+	c.abandonConnection(reader, err)
+	return NewTransportError(transportType(c.transport), message, err)
+}
+
+// await waits for the response to a sent call, cancelling the request if
+// ctx is done or the client timeout expires first
+func (c *Client) await(ctx context.Context, call *pendingCall) (*mcp.Message, error) {
+	method, requestID, reader := call.method, call.id, call.reader
 
 	// Wait for response with timeout
 	responseCtx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	for {
+	select {
+	case response := <-call.response:
+		return c.received(method, response), nil
+	case <-reader.done:
+		// The response may have been delivered just before the reader exited
+		select {
+		case response := <-call.response:
+			return c.received(method, response), nil
+		default:
+		}
+		return nil, fmt.Errorf("failed to receive response: %w: %w", ErrConnectionClosed, NewTransportError(transportType(c.transport), "reader stopped", reader.err))
+	case <-responseCtx.Done():
+		// The response may have arrived at the same moment
 		select {
-		case <-responseCtx.Done():
-			c.logger.Printf("Request %d timed out", requestID)
-			return nil, fmt.Errorf("request timeout")
+		case response := <-call.response:
+			return c.received(method, response), nil
 		default:
-			response, err := c.transport.Receive()
-			if err != nil {
-				// Mark client as disconnected if receive fails
-				c.mu.Lock()
+		}
+
+		reason := "request timed out"
+		if ctx.Err() == context.Canceled {
+			reason = "request cancelled by client"
+		}
+		c.log().Warn("request abandoned", "request_id", requestID, "method", method, "reason", reason)
+		c.cancelRequest(requestID, method, reason)
+
+		if ctx.Err() == context.Canceled {
+			return nil, fmt.Errorf("request cancelled: %w", ctx.Err())
+		}
+		return nil, fmt.Errorf("%s request %d: %w: %w", method, requestID, ErrTimeout, responseCtx.Err())
+	}
+}
+
+// received logs a response at debug level and returns it
+func (c *Client) received(method string, response *mcp.Message) *mcp.Message {
+	if response.Error != nil {
+		c.log().Debug("received error response", "method", method, "request_id", response.ID,
+			"code", response.Error.Code, "error", response.Error.Message)
+	} else {
+		c.log().Debug("received response", "method", method, "request_id", response.ID)
+	}
+	return response
+}
+
+// cancelRequest tells the server to stop working on an abandoned request and
+// arranges for its late response, if any, to be dropped silently
+func (c *Client) cancelRequest(requestID int64, method, reason string) {
+	c.pendingMu.Lock()
+	if len(c.cancelled) >= maxCancelledRequests {
+		c.cancelled = make(map[int64]struct{})
+	}
+	c.cancelled[requestID] = struct{}{}
+	c.pendingMu.Unlock()
+
+	// The spec forbids cancelling the initialize request
+	if method == "initialize" {
+		return
+	}
+
+	notification := mcp.NewNotification(mcp.NotificationCancelled, mcp.CancelledNotification{
+		RequestID: requestID,
+		Reason:    reason,
+	})
+	if err := c.transport.Send(notification); err != nil {
+		c.log().Debug("failed to send cancellation", "request_id", requestID, "method", method, "error", err)
+	}
+}
+
+// startReader launches the background reader for a fresh connection.
+// Must be called with c.mu held.
+func (c *Client) startReader() {
+	ctx, cancel := context.WithCancel(context.Background())
+	reader := &readLoop{ctx: ctx, cancel: cancel, done: make(chan struct{})}
+	c.reader = reader
+	go c.readMessages(reader)
+}
+
+// readMessages receives messages from the transport until it fails,
+// routing responses to pending requests, server requests to handleRequest
+// and everything else to handleMessage
+func (c *Client) readMessages(reader *readLoop) {
+	defer close(reader.done)
+	defer reader.cancel()
+
+	for {
+		message, err := c.transport.Receive()
+		if err != nil {
+			if errors.Is(err, transport.ErrReceiveTimeout) {
+				continue
+			}
+			reader.err = err
+
+			// Mark client as disconnected unless this reader was already
+			// replaced by Disconnect or a new Connect
+			c.mu.Lock()
+			lost := c.reader == reader
+			if lost {
+				c.reader = nil
 				c.connected = false
 				c.initialized = false
-				c.mu.Unlock()
-				return nil, fmt.Errorf("failed to receive response: %w", err)
+				c.log().Debug("reader stopped", "error", err)
 			}
+			c.mu.Unlock()
 
-			// Check if this is the response we're waiting for
-			// Handle different ID types (JSON unmarshaling might convert int64 to float64)
-			if c.isMatchingID(response.ID, requestID) {
-				return response, nil
+			if lost {
+				c.health.set(HealthDead)
+				c.connectionLost(err)
 			}
+			return
+		}
+
+		if message.Method == "" && message.ID != nil {
+			c.deliverResponse(message)
+			continue
+		}
+
+		// Handlers may call back into the client, so they must not block the reader
+		if message.Method != "" && message.ID != nil {
+			go c.handleRequest(reader.ctx, message)
+			continue
+		}
+
+		c.handleMessage(message)
+	}
+}
+
+// deliverResponse hands a response to the request waiting for its ID
+func (c *Client) deliverResponse(message *mcp.Message) {
+	id, ok := parseRequestID(message.ID)
+	if ok {
+		c.pendingMu.Lock()
+		responseCh, found := c.pending[id]
+		delete(c.pending, id)
+		c.pendingMu.Unlock()
+
+		if found {
+			responseCh <- message
+			return
+		}
 
-			// Handle notifications or other messages
-			c.handleMessage(response)
+		c.pendingMu.Lock()
+		_, wasCancelled := c.cancelled[id]
+		delete(c.cancelled, id)
+		c.pendingMu.Unlock()
+
+		if wasCancelled {
+			return
 		}
 	}
+
+	c.log().Debug("discarding response with unknown ID", "request_id", message.ID)
 }
 
 // handleMessage processes incoming messages (notifications, etc.)
 func (c *Client) handleMessage(message *mcp.Message) {
 	if message.Method != "" && message.ID == nil {
 		// This is a notification
-		c.logger.Printf("Received notification: %s", message.Method)
+		c.log().Debug("received notification", "method", message.Method)
+		c.notify(message)
+	}
+}
+
+// handleRequest answers a request initiated by the server
+func (c *Client) handleRequest(ctx context.Context, request *mcp.Message) {
+	c.log().Debug("received server request", "method", request.Method, "request_id", request.ID)
+
+	var result interface{}
+	var err error
+
+	switch request.Method {
+	case "sampling/createMessage":
+		result, err = c.handleCreateMessage(ctx, request)
+	case "roots/list":
+		result, err = c.handleListRoots(ctx, request)
+	case "elicitation/create":
+		result, err = c.handleElicit(ctx, request)
+	case "ping":
+		result = map[string]interface{}{}
+	default:
+		err = &MCPError{Code: mcp.ErrorCodeMethodNotFound, Message: fmt.Sprintf("method not found: %s", request.Method)}
+	}
+
+	var response *mcp.Message
+	if err != nil {
+		var mcpErr *MCPError
+		if errors.As(err, &mcpErr) {
+			response = mcp.NewErrorResponse(request.ID, mcpErr.Code, mcpErr.Message, mcpErr.Data)
+		} else {
+			response = mcp.NewErrorResponse(request.ID, mcp.ErrorCodeInternalError, err.Error(), nil)
+		}
+	} else {
+		response = mcp.NewResponse(request.ID, result)
+	}
+
+	if err := c.transport.Send(response); err != nil {
+		c.log().Warn("failed to send response to server request", "method", request.Method, "request_id", request.ID, "error", err)
 	}
 }
 
 // parseResult parses a response result into the target structure
 func (c *Client) parseResult(result interface{}, target interface{}) error {
-	if result == nil {
-		return fmt.Errorf("result is nil")
-	}
+	return decodeResult(result, target)
+}
 
-	if c.debug {
-		c.logger.Printf("DEBUG parseResult: Input type=%T", result)
+// decodeResult converts a generically decoded result into target
+func decodeResult(result interface{}, target interface{}) error {
+	if result == nil {
+		return fmt.Errorf("%w: result is nil", ErrInvalidResponse)
 	}
 
 	// Convert result to JSON and back to properly unmarshal into target
@@ -596,45 +1144,31 @@ func (c *Client) parseResult(result interface{}, target interface{}) error {
 		return fmt.Errorf("failed to marshal result: %w", err)
 	}
 
-	if c.debug {
-		c.logger.Printf("DEBUG parseResult: Marshaled JSON (length=%d): %s", len(jsonData), string(jsonData))
-	}
-
 	if err := json.Unmarshal(jsonData, target); err != nil {
-		if c.debug {
-			c.logger.Printf("ERROR parseResult: Unmarshal failed - %v", err)
-		}
-		return fmt.Errorf("failed to unmarshal result into %T (json=%s): %w", target, string(jsonData), err)
-	}
-
-	if c.debug {
-		c.logger.Printf("DEBUG parseResult: Successfully unmarshaled into %T: %+v", target, target)
+		return fmt.Errorf("%w: failed to unmarshal result into %T (json=%s): %w", ErrInvalidResponse, target, string(jsonData), err)
 	}
 
 	return nil
 }
 
-// isMatchingID compares request IDs, handling JSON unmarshaling type conversions
-func (c *Client) isMatchingID(responseID interface{}, requestID int64) bool {
-	if responseID == nil {
-		return false
-	}
-
+// parseRequestID converts a response ID back to the int64 used when sending,
+// handling JSON unmarshaling type conversions
+func parseRequestID(responseID interface{}) (int64, bool) {
 	switch id := responseID.(type) {
 	case int64:
-		return id == requestID
+		return id, true
 	case float64:
-		return int64(id) == requestID
+		return int64(id), true
 	case int:
-		return int64(id) == requestID
+		return int64(id), true
 	case string:
 		// Try to parse string as int
 		if parsedID, err := strconv.ParseInt(id, 10, 64); err == nil {
-			return parsedID == requestID
+			return parsedID, true
 		}
 	}
 
-	return false
+	return 0, false
 }
 
 // CheckConnection verifies the transport is still connected and updates client state
@@ -645,7 +1179,7 @@ func (c *Client) CheckConnection() error {
 	if !c.transport.IsConnected() {
 		c.connected = false
 		c.initialized = false
-		return fmt.Errorf("transport disconnected")
+		return ErrNotConnected
 	}
 
 	return nil
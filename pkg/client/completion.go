@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// Complete asks the server to suggest values for a prompt argument or a
+// resource template variable, given the partial value typed so far.
+//
+// Example:
+//
+//	completion, err := client.Complete(ctx, mcp.CompleteRequest{
+//		Ref:      mcp.PromptReference("code_review"),
+//		Argument: mcp.CompletionArgument{Name: "language", Value: "py"},
+//	})
+func (c *Client) Complete(ctx context.Context, request mcp.CompleteRequest) (*mcp.Completion, error) {
+	if !c.IsInitialized() {
+		return nil, ErrNotInitialized
+	}
+
+	// The context field was added in 2025-06-18
+	if request.Context != nil && !c.protocolAtLeast(mcp.ProtocolVersion20250618) {
+		request.Context = nil
+	}
+
+	c.log().Debug("completing argument", "ref", request.Ref.Type, "argument", request.Argument.Name, "value", request.Argument.Value)
+
+	response, err := c.sendRequest(ctx, "completion/complete", request)
+	if err != nil {
+		return nil, fmt.Errorf("complete request failed: %w", err)
+	}
+
+	if response.Error != nil {
+		return nil, fmt.Errorf("complete error: %w", newMCPError(response.Error))
+	}
+
+	var completeResponse mcp.CompleteResponse
+	if err := c.parseResult(response.Result, &completeResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse complete response: %w", err)
+	}
+	return &completeResponse.Completion, nil
+}
+
+// CompletePromptArgument suggests values for a prompt argument. filled holds
+// the values of arguments already entered, which servers may use as context.
+func (c *Client) CompletePromptArgument(ctx context.Context, prompt, argument, value string, filled map[string]string) (*mcp.Completion, error) {
+	request := mcp.CompleteRequest{
+		Ref:      mcp.PromptReference(prompt),
+		Argument: mcp.CompletionArgument{Name: argument, Value: value},
+	}
+	if len(filled) > 0 {
+		request.Context = &mcp.CompletionContext{Arguments: filled}
+	}
+	return c.Complete(ctx, request)
+}
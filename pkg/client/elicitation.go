@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// ElicitationHandler answers elicitation/create requests from the server by
+// asking the user for the requested information.
+//
+// Return a result with Action set to mcp.ElicitActionDecline or
+// mcp.ElicitActionCancel when the user does not provide the data; errors are
+// reported to the server as JSON-RPC errors (see SamplingHandler).
+type ElicitationHandler interface {
+	Elicit(ctx context.Context, request *mcp.ElicitRequest) (*mcp.ElicitResult, error)
+}
+
+// ElicitationHandlerFunc adapts an ordinary function to an ElicitationHandler
+type ElicitationHandlerFunc func(ctx context.Context, request *mcp.ElicitRequest) (*mcp.ElicitResult, error)
+
+// Elicit calls f(ctx, request)
+func (f ElicitationHandlerFunc) Elicit(ctx context.Context, request *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+	return f(ctx, request)
+}
+
+// handleElicit dispatches an elicitation/create request to the configured handler
+func (c *Client) handleElicit(ctx context.Context, request *mcp.Message) (interface{}, error) {
+	if c.elicitationHandler == nil {
+		return nil, &MCPError{Code: mcp.ErrorCodeMethodNotFound, Message: "elicitation not supported"}
+	}
+
+	var params mcp.ElicitRequest
+	if err := c.parseResult(request.Params, &params); err != nil {
+		return nil, &MCPError{Code: mcp.ErrorCodeInvalidParams, Message: err.Error()}
+	}
+
+	result, err := c.elicitationHandler.Elicit(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, &MCPError{Code: mcp.ErrorCodeInternalError, Message: "elicitation handler returned no result"}
+	}
+
+	switch result.Action {
+	case mcp.ElicitActionAccept:
+	case mcp.ElicitActionDecline, mcp.ElicitActionCancel:
+		result.Content = nil
+	default:
+		return nil, &MCPError{Code: mcp.ErrorCodeInternalError, Message: fmt.Sprintf("elicitation handler returned invalid action %q", result.Action)}
+	}
+	return result, nil
+}
@@ -1,6 +1,13 @@
 package client
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+)
 
 // Library-friendly error types for better error handling in third-party applications
 
@@ -19,9 +26,20 @@ var (
 
 	// ErrInvalidResponse indicates the server returned an invalid response
 	ErrInvalidResponse = errors.New("invalid server response")
+
+	// ErrNotFound indicates a tool, resource or prompt the server does not offer
+	ErrNotFound = errors.New("not found")
+
+	// ErrCapabilityNotSupported indicates the server did not advertise the
+	// capability an operation needs
+	ErrCapabilityNotSupported = errors.New("capability not supported")
+
+	// ErrUnsupportedProtocolVersion indicates the client and server share no protocol version
+	ErrUnsupportedProtocolVersion = errors.New("unsupported protocol version")
 )
 
-// MCPError represents an error from the MCP server
+// MCPError represents a JSON-RPC error returned by the MCP server. Errors
+// returned by Client methods wrap it, so use errors.As or IsErrorCode to inspect it.
 type MCPError struct {
 	Code    int         `json:"code"`
 	Message string      `json:"message"`
@@ -32,14 +50,44 @@ func (e *MCPError) Error() string {
 	return e.Message
 }
 
-// IsErrorCode checks if an error is an MCPError with a specific code
+// newMCPError converts the error object of a JSON-RPC response
+func newMCPError(info *mcp.ErrorInfo) *MCPError {
+	return &MCPError{
+		Code:    info.Code,
+		Message: info.Message,
+		Data:    info.Data,
+	}
+}
+
+// IsErrorCode checks if an error is, or wraps, an MCPError with a specific code
 func IsErrorCode(err error, code int) bool {
-	if mcpErr, ok := err.(*MCPError); ok {
+	var mcpErr *MCPError
+	if errors.As(err, &mcpErr) {
 		return mcpErr.Code == code
 	}
 	return false
 }
 
+// ToolError is returned by the typed tool helpers when a tool reports a
+// failure in its result (isError) rather than as a JSON-RPC error
+type ToolError struct {
+	Tool    string
+	Content []mcp.Content // The tool's description of the failure
+}
+
+func (e *ToolError) Error() string {
+	var texts []string
+	for _, content := range e.Content {
+		if content.Type == "text" && content.Text != "" {
+			texts = append(texts, content.Text)
+		}
+	}
+	if len(texts) == 0 {
+		return fmt.Sprintf("tool %q failed", e.Tool)
+	}
+	return fmt.Sprintf("tool %q failed: %s", e.Tool, strings.Join(texts, "; "))
+}
+
 // TransportError represents a transport-level error
 type TransportError struct {
 	Type    string // "tcp", "stdio", "websocket", etc.
@@ -58,6 +106,23 @@ func (e *TransportError) Unwrap() error {
 	return e.Cause
 }
 
+// transportType names a transport for TransportError.Type
+func transportType(t transport.Transport) string {
+	switch t.(type) {
+	case *transport.TCPTransport:
+		return "tcp"
+	case *transport.StdioTransport:
+		return "stdio"
+	case *transport.WebSocketTransport:
+		return "websocket"
+	case *transport.SSETransport:
+		return "sse"
+	case *transport.StreamingHTTPTransport:
+		return "http"
+	}
+	return "custom"
+}
+
 // NewTransportError creates a new transport error
 func NewTransportError(transportType, message string, cause error) *TransportError {
 	return &TransportError{
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// HealthState describes how the connection to the server is doing
+type HealthState int
+
+const (
+	// HealthUnknown means the connection is not being monitored (e.g. not yet initialized)
+	HealthUnknown HealthState = iota
+	// HealthHealthy means the last health check succeeded
+	HealthHealthy
+	// HealthDegraded means recent pings failed but the failure threshold was not reached
+	HealthDegraded
+	// HealthDead means the failure threshold was reached or the connection was lost
+	HealthDead
+)
+
+// String returns the lowercase name of the state
+func (h HealthState) String() string {
+	switch h {
+	case HealthHealthy:
+		return "healthy"
+	case HealthDegraded:
+		return "degraded"
+	case HealthDead:
+		return "dead"
+	default:
+		return "unknown"
+	}
+}
+
+// KeepAliveConfig configures periodic pings that monitor connection health
+type KeepAliveConfig struct {
+	Interval         time.Duration // Time between pings; zero disables keepalive
+	Timeout          time.Duration // Timeout for each ping; defaults to Interval
+	FailureThreshold int           // Consecutive failures before the connection is dead and closed; defaults to 3
+}
+
+// HealthChangeHandler is called when the connection health changes
+type HealthChangeHandler func(from, to HealthState)
+
+// healthMonitor tracks the health state and notifies subscribers of changes
+type healthMonitor struct {
+	mu       sync.Mutex
+	state    HealthState
+	failures int
+	handlers []*HealthChangeHandler
+}
+
+// set changes the state and calls the handlers if it differed
+func (h *healthMonitor) set(state HealthState) {
+	h.mu.Lock()
+	from := h.state
+	h.state = state
+	if state == HealthHealthy || state == HealthUnknown {
+		h.failures = 0
+	}
+	handlers := append([]*HealthChangeHandler(nil), h.handlers...)
+	h.mu.Unlock()
+
+	if from == state {
+		return
+	}
+	for _, handler := range handlers {
+		(*handler)(from, state)
+	}
+}
+
+// recordFailure counts a failed check and returns the resulting state
+func (h *healthMonitor) recordFailure(threshold int) HealthState {
+	h.mu.Lock()
+	h.failures++
+	failures := h.failures
+	h.mu.Unlock()
+
+	if failures >= threshold {
+		return HealthDead
+	}
+	return HealthDegraded
+}
+
+// Health returns the current connection health
+func (c *Client) Health() HealthState {
+	c.health.mu.Lock()
+	defer c.health.mu.Unlock()
+	return c.health.state
+}
+
+// OnHealthChange registers a handler called whenever the health state changes.
+// It returns a function that removes the handler.
+func (c *Client) OnHealthChange(handler HealthChangeHandler) func() {
+	h := &handler
+
+	c.health.mu.Lock()
+	c.health.handlers = append(c.health.handlers, h)
+	c.health.mu.Unlock()
+
+	return func() {
+		c.health.mu.Lock()
+		defer c.health.mu.Unlock()
+		for i, existing := range c.health.handlers {
+			if existing == h {
+				c.health.handlers = append(c.health.handlers[:i:i], c.health.handlers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// Ping checks that the server is responsive.
+//
+// Ping may be used before Initialize; it only requires a connection.
+func (c *Client) Ping(ctx context.Context) error {
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	response, err := c.sendRequest(ctx, "ping", nil)
+	if err != nil {
+		return fmt.Errorf("ping request failed: %w", err)
+	}
+
+	if response.Error != nil {
+		return fmt.Errorf("ping error: %w", newMCPError(response.Error))
+	}
+	return nil
+}
+
+// keepAlive pings the server every interval until ctx, derived from the
+// reader's context, is done, updating the health state after each attempt
+func (c *Client) keepAlive(ctx context.Context, reader *readLoop) {
+	config := c.keepAliveConfig
+	ticker := time.NewTicker(config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, config.Timeout)
+		err := c.Ping(pingCtx)
+		cancel()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err == nil {
+			c.health.set(HealthHealthy)
+			continue
+		}
+
+		state := c.health.recordFailure(config.FailureThreshold)
+		c.log().Debug("keepalive ping failed", "health", state, "error", err)
+		c.health.set(state)
+
+		if state == HealthDead {
+			// An unresponsive server is treated like a lost connection
+			c.abandonConnection(reader, fmt.Errorf("server stopped answering pings: %w", err))
+			return
+		}
+	}
+}
+
+// startKeepAlive starts pinging over reader's connection, stopping the loop of
+// any earlier session so re-initializing doesn't add another one.
+// Must be called with c.mu held.
+func (c *Client) startKeepAlive(reader *readLoop) {
+	if c.stopKeepAlive != nil {
+		c.stopKeepAlive()
+		c.stopKeepAlive = nil
+	}
+	if c.keepAliveConfig.Interval <= 0 || reader == nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(reader.ctx)
+	c.stopKeepAlive = cancel
+	go c.keepAlive(ctx, reader)
+}
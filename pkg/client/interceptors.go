@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// Request is an outgoing client request as seen by interceptors.
+// Interceptors may replace Params, e.g. to redact arguments or add metadata.
+type Request struct {
+	Method string
+	Params interface{}
+	ID     int64 // JSON-RPC ID, assigned when the request is sent; zero until then
+}
+
+// SetMeta sets a key in the request's _meta object, converting Params to a
+// generic JSON object if needed
+func (r *Request) SetMeta(key string, value interface{}) error {
+	params, ok := r.Params.(map[string]interface{})
+	if !ok {
+		params = make(map[string]interface{})
+		if r.Params != nil {
+			data, err := json.Marshal(r.Params)
+			if err != nil {
+				return fmt.Errorf("failed to marshal %s params: %w", r.Method, err)
+			}
+			if err := json.Unmarshal(data, &params); err != nil {
+				return fmt.Errorf("%s params are not an object: %w", r.Method, err)
+			}
+		}
+	}
+
+	meta, _ := params["_meta"].(map[string]interface{})
+	if meta == nil {
+		meta = make(map[string]interface{})
+	}
+	meta[key] = value
+	params["_meta"] = meta
+	r.Params = params
+	return nil
+}
+
+// RequestInvoker sends a request and waits for the server's response
+type RequestInvoker func(ctx context.Context, req *Request) (*mcp.Message, error)
+
+// RequestInterceptor wraps every request the client sends. It must call next
+// to continue the chain, and may inspect or change the request before and the
+// response or error after, call next several times (retries) or not at all
+// (short-circuit). A JSON-RPC error from the server is returned as a response
+// whose Error is set, not as an error.
+//
+// Interceptors run in the order they were configured: the first one sees the
+// request first and the response last.
+type RequestInterceptor func(ctx context.Context, req *Request, next RequestInvoker) (*mcp.Message, error)
+
+// NotificationInvoker delivers an incoming notification to the client's handlers
+type NotificationInvoker func(notification *mcp.Message)
+
+// NotificationInterceptor wraps the delivery of every notification received
+// from the server. Not calling next drops the notification. Interceptors run
+// in the order they were configured.
+type NotificationInterceptor func(notification *mcp.Message, next NotificationInvoker)
+
+// chainRequestInterceptors builds an invoker that runs interceptors in order around final
+func chainRequestInterceptors(interceptors []RequestInterceptor, final RequestInvoker) RequestInvoker {
+	invoker := final
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, req *Request) (*mcp.Message, error) {
+			return interceptor(ctx, req, next)
+		}
+	}
+	return invoker
+}
+
+// chainNotificationInterceptors builds an invoker that runs interceptors in order around final
+func chainNotificationInterceptors(interceptors []NotificationInterceptor, final NotificationInvoker) NotificationInvoker {
+	invoker := final
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(notification *mcp.Message) {
+			interceptor(notification, next)
+		}
+	}
+	return invoker
+}
+
+// LoggingInterceptor logs the method, request ID, latency and outcome of
+// every request: successes at info level, failures and error responses at warn
+func LoggingInterceptor(logger *slog.Logger) RequestInterceptor {
+	return func(ctx context.Context, req *Request, next RequestInvoker) (*mcp.Message, error) {
+		start := time.Now()
+		response, err := next(ctx, req)
+		latency := time.Since(start)
+
+		switch {
+		case err != nil:
+			logger.WarnContext(ctx, "request failed", "method", req.Method, "request_id", req.ID, "latency", latency, "error", err)
+		case response == nil:
+			logger.WarnContext(ctx, "request returned no response", "method", req.Method, "request_id", req.ID, "latency", latency)
+		case response.Error != nil:
+			logger.WarnContext(ctx, "request returned an error", "method", req.Method, "request_id", req.ID, "latency", latency,
+				"code", response.Error.Code, "error", response.Error.Message)
+		default:
+			logger.InfoContext(ctx, "request succeeded", "method", req.Method, "request_id", req.ID, "latency", latency)
+		}
+		return response, err
+	}
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// LogSink receives log entries sent by the server in notifications/message.
+//
+// HandleLog runs on the client's reader goroutine, like notification
+// handlers, so it must return quickly.
+type LogSink interface {
+	HandleLog(entry mcp.LoggingMessageNotification)
+}
+
+// LogSinkFunc adapts an ordinary function to the LogSink interface
+type LogSinkFunc func(entry mcp.LoggingMessageNotification)
+
+// HandleLog calls f(entry)
+func (f LogSinkFunc) HandleLog(entry mcp.LoggingMessageNotification) {
+	f(entry)
+}
+
+// slog levels for the syslog severities that have no direct slog equivalent
+const (
+	slogLevelNotice    = slog.LevelInfo + 2
+	slogLevelCritical  = slog.LevelError + 4
+	slogLevelAlert     = slog.LevelError + 8
+	slogLevelEmergency = slog.LevelError + 12
+)
+
+// SlogLevel maps an MCP log level to the closest slog level
+func SlogLevel(level mcp.LogLevel) slog.Level {
+	switch level {
+	case mcp.LogLevelDebug:
+		return slog.LevelDebug
+	case mcp.LogLevelNotice:
+		return slogLevelNotice
+	case mcp.LogLevelWarning:
+		return slog.LevelWarn
+	case mcp.LogLevelError:
+		return slog.LevelError
+	case mcp.LogLevelCritical:
+		return slogLevelCritical
+	case mcp.LogLevelAlert:
+		return slogLevelAlert
+	case mcp.LogLevelEmergency:
+		return slogLevelEmergency
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// slogSink forwards server log entries to a slog.Logger
+type slogSink struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogSink returns a sink that writes server log entries to logger.
+// String data becomes the message; other data is attached as a "data" attribute.
+func NewSlogLogSink(logger *slog.Logger) LogSink {
+	return &slogSink{logger: logger}
+}
+
+// HandleLog implements LogSink
+func (s *slogSink) HandleLog(entry mcp.LoggingMessageNotification) {
+	message := "server log"
+	attrs := []slog.Attr{}
+	if text, ok := entry.Data.(string); ok {
+		message = text
+	} else {
+		attrs = append(attrs, slog.Any("data", entry.Data))
+	}
+	if entry.Logger != "" {
+		attrs = append(attrs, slog.String("logger", entry.Logger))
+	}
+	s.logger.LogAttrs(context.Background(), SlogLevel(entry.Level), message, attrs...)
+}
+
+// FileLogSink appends server log entries to a writer as JSON lines with a timestamp
+type FileLogSink struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+// NewFileLogSink opens (or creates) path for appending and writes entries to it
+func NewFileLogSink(path string) (*FileLogSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+	return &FileLogSink{w: file, closer: file}, nil
+}
+
+// NewWriterLogSink writes entries as JSON lines to w, which the caller owns
+func NewWriterLogSink(w io.Writer) *FileLogSink {
+	return &FileLogSink{w: w}
+}
+
+// fileLogRecord is the JSON line written for each entry
+type fileLogRecord struct {
+	Time   time.Time    `json:"time"`
+	Level  mcp.LogLevel `json:"level"`
+	Logger string       `json:"logger,omitempty"`
+	Data   interface{}  `json:"data"`
+}
+
+// HandleLog implements LogSink
+func (s *FileLogSink) HandleLog(entry mcp.LoggingMessageNotification) {
+	line, err := json.Marshal(fileLogRecord{
+		Time:   time.Now(),
+		Level:  entry.Level,
+		Logger: entry.Logger,
+		Data:   entry.Data,
+	})
+	if err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.w.Write(append(line, '\n'))
+}
+
+// Close closes the file opened by NewFileLogSink; it does nothing for NewWriterLogSink
+func (s *FileLogSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+// AddLogSink routes server log entries to sink. It returns a function that removes the sink.
+func (c *Client) AddLogSink(sink LogSink) func() {
+	return c.OnLogMessage(sink.HandleLog)
+}
+
+// SetLogLevel asks the server to send log messages at level or above.
+//
+// The server must advertise the logging capability. Entries arrive as
+// notifications/message and are passed to the configured LogSink, any sinks
+// added with AddLogSink and OnLogMessage handlers.
+func (c *Client) SetLogLevel(ctx context.Context, level mcp.LogLevel) error {
+	if !c.IsInitialized() {
+		return ErrNotInitialized
+	}
+	if level.Severity() < 0 {
+		return fmt.Errorf("invalid log level %q", level)
+	}
+	if caps := c.GetServerCapabilities(); caps == nil || caps.Logging == nil {
+		return fmt.Errorf("server does not support logging: %w", ErrCapabilityNotSupported)
+	}
+
+	c.log().Debug("setting server log level", "level", level)
+
+	response, err := c.sendRequest(ctx, "logging/setLevel", mcp.SetLevelRequest{Level: level})
+	if err != nil {
+		return fmt.Errorf("set log level request failed: %w", err)
+	}
+	if response.Error != nil {
+		return fmt.Errorf("set log level error: %w", newMCPError(response.Error))
+	}
+	return nil
+}
+
+// discardHandler drops every record; it is the client's handler when no
+// Logger is configured, so a library user who sets none sees no output
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
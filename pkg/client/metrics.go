@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/metrics"
+)
+
+// clientMetrics are the series a client updates in ClientConfig.Metrics.
+// Every series is labelled with the client name and transport type so that
+// several clients can share a registry.
+type clientMetrics struct {
+	client    string
+	transport string
+
+	requests *metrics.CounterVec
+	errors   *metrics.CounterVec
+	duration *metrics.HistogramVec
+	inFlight *metrics.GaugeVec
+	events   *metrics.CounterVec
+}
+
+// newClientMetrics registers the client metrics in registry
+func newClientMetrics(registry *metrics.Registry, client, transport string) *clientMetrics {
+	return &clientMetrics{
+		client:    client,
+		transport: transport,
+
+		requests: registry.Counter("mcp_client_requests_total",
+			"Requests sent to MCP servers.", "client", "transport", "method"),
+		errors: registry.Counter("mcp_client_request_errors_total",
+			"Failed requests, by JSON-RPC error code or timeout, cancelled, transport, tool_error or other.",
+			"client", "transport", "method", "code"),
+		duration: registry.Histogram("mcp_client_request_duration_seconds",
+			"Time from sending a request to receiving its response.", nil, "client", "transport", "method"),
+		inFlight: registry.Gauge("mcp_client_requests_in_flight",
+			"Requests awaiting a response.", "client", "transport", "method"),
+		events: registry.Counter("mcp_client_connection_events_total",
+			"Connection lifecycle events: lost, reconnecting, restored and reconnect_failed.",
+			"client", "transport", "event"),
+	}
+}
+
+// interceptor counts and times every request
+func (m *clientMetrics) interceptor() RequestInterceptor {
+	return func(ctx context.Context, req *Request, next RequestInvoker) (*mcp.Message, error) {
+		inFlight := m.inFlight.With(m.client, m.transport, req.Method)
+		inFlight.Inc()
+		start := time.Now()
+
+		response, err := next(ctx, req)
+
+		m.duration.With(m.client, m.transport, req.Method).Observe(time.Since(start).Seconds())
+		inFlight.Dec()
+		m.requests.With(m.client, m.transport, req.Method).Inc()
+		if code := requestErrorCode(response, err); code != "" {
+			m.errors.With(m.client, m.transport, req.Method, code).Inc()
+		}
+		return response, err
+	}
+}
+
+// connectionEvent counts a lifecycle event
+func (m *clientMetrics) connectionEvent(event ConnectionEvent) {
+	m.events.With(m.client, m.transport, strings.ReplaceAll(event.Type.String(), " ", "_")).Inc()
+}
+
+// requestErrorCode classifies a failed request for the code label; it
+// returns "" for a successful one
+func requestErrorCode(response *mcp.Message, err error) string {
+	switch {
+	case errors.Is(err, ErrTimeout):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "cancelled"
+	case errors.Is(err, ErrNotConnected), errors.Is(err, ErrConnectionClosed):
+		return "transport"
+	case err != nil:
+		var transportErr *TransportError
+		if errors.As(err, &transportErr) {
+			return "transport"
+		}
+		return "other"
+	case response == nil:
+		return "other"
+	case response.Error != nil:
+		return strconv.Itoa(response.Error.Code)
+	}
+	if result, ok := response.Result.(map[string]interface{}); ok && result["isError"] == true {
+		return "tool_error"
+	}
+	return ""
+}
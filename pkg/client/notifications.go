@@ -0,0 +1,167 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// AllNotifications subscribes a handler to every notification from the server
+const AllNotifications = "*"
+
+// NotificationHandler is called for each matching notification from the server.
+//
+// Handlers run on the client's reader goroutine in the order they were
+// registered, so they must return quickly and must not wait on requests to
+// the same client; start a goroutine for slow work.
+type NotificationHandler func(notification *mcp.Message)
+
+// notificationSubscription identifies one registered handler so it can be removed
+type notificationSubscription struct {
+	handler NotificationHandler
+}
+
+// notificationRegistry holds notification handlers keyed by method
+type notificationRegistry struct {
+	mu       sync.RWMutex
+	handlers map[string][]*notificationSubscription
+}
+
+// add registers a handler and returns a function that removes it
+func (r *notificationRegistry) add(method string, handler NotificationHandler) func() {
+	sub := &notificationSubscription{handler: handler}
+
+	r.mu.Lock()
+	if r.handlers == nil {
+		r.handlers = make(map[string][]*notificationSubscription)
+	}
+	r.handlers[method] = append(r.handlers[method], sub)
+	r.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			subs := r.handlers[method]
+			for i, existing := range subs {
+				if existing == sub {
+					r.handlers[method] = append(subs[:i:i], subs[i+1:]...)
+					break
+				}
+			}
+		})
+	}
+}
+
+// matching returns the handlers for a method followed by the catch-all handlers
+func (r *notificationRegistry) matching(method string) []NotificationHandler {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var handlers []NotificationHandler
+	for _, sub := range r.handlers[method] {
+		handlers = append(handlers, sub.handler)
+	}
+	for _, sub := range r.handlers[AllNotifications] {
+		handlers = append(handlers, sub.handler)
+	}
+	return handlers
+}
+
+// OnNotification registers a handler for notifications with the given method,
+// or for every notification when method is AllNotifications.
+//
+// It returns a function that removes the handler; calling it more than once is safe.
+//
+// Example:
+//
+//	unsubscribe := client.OnNotification(mcp.NotificationToolListChanged, func(n *mcp.Message) {
+//		go refreshTools()
+//	})
+//	defer unsubscribe()
+func (c *Client) OnNotification(method string, handler NotificationHandler) func() {
+	return c.notifications.add(method, handler)
+}
+
+// OnToolsListChanged registers a handler for notifications/tools/list_changed
+func (c *Client) OnToolsListChanged(handler func()) func() {
+	return c.OnNotification(mcp.NotificationToolListChanged, func(*mcp.Message) { handler() })
+}
+
+// OnResourcesListChanged registers a handler for notifications/resources/list_changed
+func (c *Client) OnResourcesListChanged(handler func()) func() {
+	return c.OnNotification(mcp.NotificationResourceListChanged, func(*mcp.Message) { handler() })
+}
+
+// OnPromptsListChanged registers a handler for notifications/prompts/list_changed
+func (c *Client) OnPromptsListChanged(handler func()) func() {
+	return c.OnNotification(mcp.NotificationPromptListChanged, func(*mcp.Message) { handler() })
+}
+
+// OnResourceUpdated registers a handler for notifications/resources/updated
+func (c *Client) OnResourceUpdated(handler func(mcp.ResourceUpdatedNotification)) func() {
+	return c.OnNotification(mcp.NotificationResourceUpdated, func(n *mcp.Message) {
+		var params mcp.ResourceUpdatedNotification
+		if c.parseNotification(n, &params) {
+			handler(params)
+		}
+	})
+}
+
+// OnLogMessage registers a handler for notifications/message log entries
+func (c *Client) OnLogMessage(handler func(mcp.LoggingMessageNotification)) func() {
+	return c.OnNotification(mcp.NotificationMessage, func(n *mcp.Message) {
+		var params mcp.LoggingMessageNotification
+		if c.parseNotification(n, &params) {
+			handler(params)
+		}
+	})
+}
+
+// OnProgress registers a handler for notifications/progress
+func (c *Client) OnProgress(handler func(mcp.ProgressNotification)) func() {
+	return c.OnNotification(mcp.NotificationProgress, func(n *mcp.Message) {
+		var params mcp.ProgressNotification
+		if c.parseNotification(n, &params) {
+			handler(params)
+		}
+	})
+}
+
+// parseNotification decodes notification params, logging and reporting false on failure
+func (c *Client) parseNotification(notification *mcp.Message, target interface{}) bool {
+	if err := c.parseResult(notification.Params, target); err != nil {
+		c.log().Warn("ignoring malformed notification", "method", notification.Method, "error", err)
+		return false
+	}
+	return true
+}
+
+// dispatchNotification calls every handler registered for the notification's method,
+// after routing progress updates to the call that requested them, resource
+// updates to their subscriptions and list changes to the catalog cache
+func (c *Client) dispatchNotification(notification *mcp.Message) {
+	switch notification.Method {
+	case mcp.NotificationProgress:
+		c.callNotificationHandler(c.routeProgress, notification)
+	case mcp.NotificationResourceUpdated:
+		c.callNotificationHandler(c.routeResourceUpdate, notification)
+	case mcp.NotificationToolListChanged, mcp.NotificationResourceListChanged, mcp.NotificationPromptListChanged:
+		c.callNotificationHandler(c.routeListChanged, notification)
+	}
+
+	for _, handler := range c.notifications.matching(notification.Method) {
+		c.callNotificationHandler(handler, notification)
+	}
+}
+
+// callNotificationHandler runs one handler, keeping a panic from killing the reader
+func (c *Client) callNotificationHandler(handler NotificationHandler, notification *mcp.Message) {
+	defer func() {
+		if r := recover(); r != nil {
+			c.log().Error("notification handler panicked", "method", notification.Method, "panic", r)
+		}
+	}()
+	handler(notification)
+}
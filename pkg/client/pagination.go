@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// pageFetcher retrieves the page of items starting at cursor and the cursor for the next page
+type pageFetcher[T any] func(ctx context.Context, cursor string) ([]T, string, error)
+
+// Iterator streams the items of a paginated list one page at a time,
+// fetching the next page only when the current one is exhausted.
+//
+// Example:
+//
+//	it := client.ToolsIterator(ctx)
+//	for it.Next() {
+//		fmt.Println(it.Item().Name)
+//	}
+//	if err := it.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+type Iterator[T any] struct {
+	ctx      context.Context
+	fetch    pageFetcher[T]
+	maxPages int
+
+	page   []T
+	index  int
+	item   T
+	cursor string
+	pages  int
+	done   bool
+	err    error
+}
+
+// newIterator creates an iterator starting at the first page
+func newIterator[T any](ctx context.Context, maxPages int, fetch pageFetcher[T]) *Iterator[T] {
+	return &Iterator[T]{ctx: ctx, fetch: fetch, maxPages: maxPages}
+}
+
+// Next advances to the next item, fetching another page if needed.
+// It returns false when all items were consumed or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	for it.index >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		if it.pages >= it.maxPages {
+			it.err = fmt.Errorf("pagination stopped after %d pages (more results remain)", it.maxPages)
+			return false
+		}
+
+		items, next, err := it.fetch(it.ctx, it.cursor)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.pages++
+
+		if next != "" && next == it.cursor {
+			// Yield this page, then stop instead of requesting it forever
+			it.err = fmt.Errorf("server returned the same pagination cursor %q twice", next)
+			it.done = true
+		}
+
+		it.page = items
+		it.index = 0
+		it.cursor = next
+		if next == "" {
+			it.done = true
+		}
+	}
+
+	it.item = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Item returns the current item; valid after Next returned true
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error that stopped iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Cursor returns the cursor for the page after the current one,
+// or "" once the last page has been fetched
+func (it *Iterator[T]) Cursor() string {
+	return it.cursor
+}
+
+// collectAll drains an iterator into a slice
+func collectAll[T any](it *Iterator[T]) ([]T, error) {
+	items := []T{}
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ToolsIterator returns an iterator over all tools, fetching pages on demand
+func (c *Client) ToolsIterator(ctx context.Context) *Iterator[mcp.Tool] {
+	return newIterator(ctx, c.maxPages, func(ctx context.Context, cursor string) ([]mcp.Tool, string, error) {
+		page, err := c.ListToolsPage(ctx, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Tools, page.NextCursor, nil
+	})
+}
+
+// ResourcesIterator returns an iterator over all resources, fetching pages on demand
+func (c *Client) ResourcesIterator(ctx context.Context) *Iterator[mcp.Resource] {
+	return newIterator(ctx, c.maxPages, func(ctx context.Context, cursor string) ([]mcp.Resource, string, error) {
+		page, err := c.ListResourcesPage(ctx, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Resources, page.NextCursor, nil
+	})
+}
+
+// ResourceTemplatesIterator returns an iterator over all resource templates, fetching pages on demand
+func (c *Client) ResourceTemplatesIterator(ctx context.Context) *Iterator[mcp.ResourceTemplate] {
+	return newIterator(ctx, c.maxPages, func(ctx context.Context, cursor string) ([]mcp.ResourceTemplate, string, error) {
+		page, err := c.ListResourceTemplatesPage(ctx, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.ResourceTemplates, page.NextCursor, nil
+	})
+}
+
+// PromptsIterator returns an iterator over all prompts, fetching pages on demand
+func (c *Client) PromptsIterator(ctx context.Context) *Iterator[mcp.Prompt] {
+	return newIterator(ctx, c.maxPages, func(ctx context.Context, cursor string) ([]mcp.Prompt, string, error) {
+		page, err := c.ListPromptsPage(ctx, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Prompts, page.NextCursor, nil
+	})
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// CallToolOption customizes a single CallTool invocation
+type CallToolOption func(*callToolOptions)
+
+// callToolOptions holds the settings applied by CallToolOptions
+type callToolOptions struct {
+	progress     func(mcp.ProgressNotification)
+	outputSchema map[string]interface{}
+}
+
+// WithProgress attaches a progress token to the call and passes every
+// notifications/progress update for it to handler.
+//
+// The handler runs on the client's reader goroutine (see NotificationHandler)
+// and is no longer called once CallTool returns.
+func WithProgress(handler func(mcp.ProgressNotification)) CallToolOption {
+	return func(o *callToolOptions) {
+		o.progress = handler
+	}
+}
+
+// WithProgressChannel is like WithProgress but sends updates to ch.
+// Updates are dropped rather than blocking the reader if ch is full.
+func WithProgressChannel(ch chan<- mcp.ProgressNotification) CallToolOption {
+	return WithProgress(func(p mcp.ProgressNotification) {
+		select {
+		case ch <- p:
+		default:
+		}
+	})
+}
+
+// progressTracker routes progress notifications to the call that issued the token
+type progressTracker struct {
+	mu       sync.RWMutex
+	next     int64
+	handlers map[string]func(mcp.ProgressNotification)
+}
+
+// register issues a new progress token for handler and returns it with a release function
+func (p *progressTracker) register(handler func(mcp.ProgressNotification)) (string, func()) {
+	token := fmt.Sprintf("progress-%d", atomic.AddInt64(&p.next, 1))
+
+	p.mu.Lock()
+	if p.handlers == nil {
+		p.handlers = make(map[string]func(mcp.ProgressNotification))
+	}
+	p.handlers[token] = handler
+	p.mu.Unlock()
+
+	return token, func() {
+		p.mu.Lock()
+		delete(p.handlers, token)
+		p.mu.Unlock()
+	}
+}
+
+// route passes a progress notification to the handler for its token, if any
+func (p *progressTracker) route(notification mcp.ProgressNotification) {
+	p.mu.RLock()
+	handler := p.handlers[fmt.Sprint(notification.ProgressToken)]
+	p.mu.RUnlock()
+
+	if handler != nil {
+		handler(notification)
+	}
+}
+
+// routeProgress delivers a notifications/progress message to the call tracking its token
+func (c *Client) routeProgress(notification *mcp.Message) {
+	var params mcp.ProgressNotification
+	if c.parseNotification(notification, &params) {
+		c.progress.route(params)
+	}
+}
@@ -0,0 +1,291 @@
+package client
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// ReconnectConfig configures automatic reconnection after the connection is lost.
+//
+// When a send or receive fails, the client waits with exponential backoff,
+// connects the transport again, repeats the initialize handshake with the
+// ClientInfo from the last successful Initialize and restores resource
+// subscriptions. Progress is reported through Client.OnConnectionEvent.
+type ReconnectConfig struct {
+	MaxAttempts  int           // Attempts before giving up; zero disables reconnection, negative retries forever
+	InitialDelay time.Duration // Delay before the first attempt; defaults to 500ms
+	MaxDelay     time.Duration // Upper bound for the delay between attempts; defaults to 30s
+	Multiplier   float64       // Growth of the delay after each attempt; defaults to 2
+	Jitter       float64       // Fraction of each delay that is randomized; defaults to 0.2, negative disables
+}
+
+// enabled reports whether reconnection is configured
+func (r ReconnectConfig) enabled() bool {
+	return r.MaxAttempts != 0
+}
+
+// withDefaults fills in unset fields
+func (r ReconnectConfig) withDefaults() ReconnectConfig {
+	if r.InitialDelay <= 0 {
+		r.InitialDelay = 500 * time.Millisecond
+	}
+	if r.MaxDelay <= 0 {
+		r.MaxDelay = 30 * time.Second
+	}
+	if r.MaxDelay < r.InitialDelay {
+		r.MaxDelay = r.InitialDelay
+	}
+	if r.Multiplier < 1 {
+		r.Multiplier = 2
+	}
+	if r.Jitter == 0 {
+		r.Jitter = 0.2
+	}
+	if r.Jitter > 1 {
+		r.Jitter = 1
+	}
+	return r
+}
+
+// delay returns how long to wait before the given attempt, starting at 1
+func (r ReconnectConfig) delay(attempt int) time.Duration {
+	backoff := float64(r.InitialDelay) * math.Pow(r.Multiplier, float64(attempt-1))
+	if backoff > float64(r.MaxDelay) {
+		backoff = float64(r.MaxDelay)
+	}
+	if r.Jitter > 0 {
+		// Spread clients that lost the same server so they don't reconnect in lockstep
+		backoff *= 1 - r.Jitter + 2*r.Jitter*rand.Float64()
+	}
+	return time.Duration(backoff)
+}
+
+// ConnectionEventType identifies a connection lifecycle event
+type ConnectionEventType int
+
+const (
+	// ConnectionLost means the transport failed while the client was connected
+	ConnectionLost ConnectionEventType = iota
+	// ConnectionReconnecting means a reconnect attempt is scheduled after Delay
+	ConnectionReconnecting
+	// ConnectionRestored means a reconnect attempt succeeded
+	ConnectionRestored
+	// ConnectionReconnectFailed means every reconnect attempt failed; the client stays disconnected
+	ConnectionReconnectFailed
+)
+
+// String returns the lowercase name of the event type
+func (t ConnectionEventType) String() string {
+	switch t {
+	case ConnectionLost:
+		return "lost"
+	case ConnectionReconnecting:
+		return "reconnecting"
+	case ConnectionRestored:
+		return "restored"
+	case ConnectionReconnectFailed:
+		return "reconnect failed"
+	default:
+		return "unknown"
+	}
+}
+
+// ConnectionEvent describes a change in the connection lifecycle
+type ConnectionEvent struct {
+	Type    ConnectionEventType
+	Attempt int           // Reconnect attempt, starting at 1; zero for ConnectionLost
+	Delay   time.Duration // Wait before the attempt, for ConnectionReconnecting
+	Err     error         // Why the connection was lost or the previous attempt failed
+}
+
+// ConnectionEventHandler is called for each connection lifecycle event
+type ConnectionEventHandler func(event ConnectionEvent)
+
+// connectionEvents holds the registered lifecycle handlers
+type connectionEvents struct {
+	mu       sync.Mutex
+	handlers []*ConnectionEventHandler
+}
+
+// emit calls every handler with event
+func (e *connectionEvents) emit(event ConnectionEvent) {
+	e.mu.Lock()
+	handlers := append([]*ConnectionEventHandler(nil), e.handlers...)
+	e.mu.Unlock()
+
+	for _, handler := range handlers {
+		(*handler)(event)
+	}
+}
+
+// reconnectRun tracks one reconnect loop, cancelled by Disconnect
+type reconnectRun struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// OnConnectionEvent registers a handler for connection lifecycle events.
+// It returns a function that removes the handler.
+func (c *Client) OnConnectionEvent(handler ConnectionEventHandler) func() {
+	h := &handler
+
+	c.events.mu.Lock()
+	c.events.handlers = append(c.events.handlers, h)
+	c.events.mu.Unlock()
+
+	return func() {
+		c.events.mu.Lock()
+		defer c.events.mu.Unlock()
+		for i, existing := range c.events.handlers {
+			if existing == h {
+				c.events.handlers = append(c.events.handlers[:i:i], c.events.handlers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// IsReconnecting returns true while the client is trying to restore a lost connection
+func (c *Client) IsReconnecting() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.reconnecting != nil
+}
+
+// connectionLost reports a failed connection and starts reconnecting if enabled.
+// Failures of the connections made by the reconnect loop itself are not reported.
+func (c *Client) connectionLost(err error) {
+	c.mu.Lock()
+	if c.reconnecting != nil {
+		c.mu.Unlock()
+		return
+	}
+	var run *reconnectRun
+	if c.reconnectConfig.enabled() {
+		ctx, cancel := context.WithCancel(context.Background())
+		run = &reconnectRun{ctx: ctx, cancel: cancel}
+		c.reconnecting = run
+	}
+	c.mu.Unlock()
+
+	c.events.emit(ConnectionEvent{Type: ConnectionLost, Err: err})
+
+	if run != nil {
+		go c.reconnect(run)
+	}
+}
+
+// abandonConnection gives up on a connection known to be broken, such as one
+// that failed to send or whose server stopped answering pings. The transport is closed and the reader
+// detached whether or not reconnection is enabled, so the client stops
+// reporting itself connected and the next Connect starts afresh. The loss is
+// then reported as if the reader had failed. Nothing happens if reader is no
+// longer the current one.
+func (c *Client) abandonConnection(reader *readLoop, err error) {
+	c.mu.Lock()
+	if reader == nil || c.reader != reader {
+		c.mu.Unlock()
+		return
+	}
+	reader.cancel()
+	if closeErr := c.transport.Close(); closeErr != nil {
+		c.log().Debug("failed to close broken transport", "error", closeErr)
+	}
+	c.reader = nil
+	c.connected = false
+	c.initialized = false
+	c.mu.Unlock()
+
+	c.health.set(HealthDead)
+	c.connectionLost(err)
+}
+
+// reconnect retries reestablish with backoff until it succeeds, the attempts
+// run out or Disconnect cancels the run
+func (c *Client) reconnect(run *reconnectRun) {
+	// finish ends the run before the final event, so handlers see its outcome
+	finish := func() {
+		c.mu.Lock()
+		if c.reconnecting == run {
+			c.reconnecting = nil
+		}
+		c.mu.Unlock()
+		run.cancel()
+	}
+	defer finish()
+
+	config := c.reconnectConfig
+	var lastErr error
+	attempt := 1
+	for ; config.MaxAttempts < 0 || attempt <= config.MaxAttempts; attempt++ {
+		delay := config.delay(attempt)
+		c.events.emit(ConnectionEvent{Type: ConnectionReconnecting, Attempt: attempt, Delay: delay, Err: lastErr})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-run.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if lastErr = c.reestablish(run.ctx); lastErr == nil {
+			c.log().Info("connection restored", "attempt", attempt)
+			finish()
+			c.events.emit(ConnectionEvent{Type: ConnectionRestored, Attempt: attempt})
+			return
+		}
+		if run.ctx.Err() != nil {
+			return
+		}
+		c.log().Debug("reconnect attempt failed", "attempt", attempt, "error", lastErr)
+	}
+
+	c.log().Warn("giving up reconnecting", "attempts", attempt-1, "error", lastErr)
+	finish()
+	c.events.emit(ConnectionEvent{Type: ConnectionReconnectFailed, Attempt: attempt - 1, Err: lastErr})
+}
+
+// reestablish connects the transport again and repeats the handshake of the
+// last successful Initialize, if any
+func (c *Client) reestablish(ctx context.Context) error {
+	// Release whatever is left of the old connection; the transport may
+	// still consider itself connected after the peer went away
+	c.transport.Close()
+
+	// Some transports tie the connection's lifetime to the context passed to
+	// Connect (the stdio transport kills its process when it is done), so the
+	// connection must outlive the run, which ends as soon as it succeeds
+	if err := c.Connect(context.Background()); err != nil {
+		return err
+	}
+	if ctx.Err() != nil {
+		// Disconnect was called while connecting
+		c.disconnect()
+		return ctx.Err()
+	}
+
+	c.mu.RLock()
+	clientInfo := c.clientInfo
+	c.mu.RUnlock()
+	if clientInfo == nil {
+		return nil
+	}
+
+	initCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	if err := c.Initialize(initCtx, *clientInfo); err != nil {
+		c.disconnect()
+		return err
+	}
+
+	if ctx.Err() != nil {
+		// Disconnect was called while the handshake was in flight
+		c.disconnect()
+		return ctx.Err()
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// Roots returns a copy of the roots currently exposed to the server
+func (c *Client) Roots() []mcp.Root {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]mcp.Root(nil), c.roots...)
+}
+
+// SetRoots replaces the roots exposed to the server.
+//
+// Every root must use a file:// URI (see mcp.NewFileRoot). If the roots
+// capability was advertised and the session is initialized, the server is
+// sent notifications/roots/list_changed so it can fetch the new list.
+func (c *Client) SetRoots(roots []mcp.Root) error {
+	for _, root := range roots {
+		if err := mcp.ValidateRoot(root); err != nil {
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	c.roots = append([]mcp.Root(nil), roots...)
+	c.mu.Unlock()
+
+	return c.notifyRootsChanged()
+}
+
+// AddRoot exposes an additional root to the server, replacing any root with the same URI
+func (c *Client) AddRoot(root mcp.Root) error {
+	if err := mcp.ValidateRoot(root); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	roots := make([]mcp.Root, 0, len(c.roots)+1)
+	for _, existing := range c.roots {
+		if existing.URI != root.URI {
+			roots = append(roots, existing)
+		}
+	}
+	c.roots = append(roots, root)
+	c.mu.Unlock()
+
+	return c.notifyRootsChanged()
+}
+
+// RemoveRoot stops exposing the root with the given URI.
+// It returns an error if no such root exists.
+func (c *Client) RemoveRoot(uri string) error {
+	c.mu.Lock()
+	roots := make([]mcp.Root, 0, len(c.roots))
+	for _, existing := range c.roots {
+		if existing.URI != uri {
+			roots = append(roots, existing)
+		}
+	}
+	removed := len(roots) != len(c.roots)
+	c.roots = roots
+	c.mu.Unlock()
+
+	if !removed {
+		return fmt.Errorf("root %s: %w", uri, ErrNotFound)
+	}
+	return c.notifyRootsChanged()
+}
+
+// notifyRootsChanged tells the server the roots list changed, if it can act on it
+func (c *Client) notifyRootsChanged() error {
+	c.mu.RLock()
+	notify := c.rootsEnabled && c.initialized
+	c.mu.RUnlock()
+
+	if !notify {
+		return nil
+	}
+
+	c.log().Debug("sending roots list_changed notification")
+	notification := mcp.NewNotification(mcp.NotificationRootsListChanged, nil)
+	if err := c.transport.Send(notification); err != nil {
+		return fmt.Errorf("failed to send roots list_changed notification: %w", err)
+	}
+	return nil
+}
+
+// handleListRoots answers a roots/list request from the server
+func (c *Client) handleListRoots(ctx context.Context, request *mcp.Message) (interface{}, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.rootsEnabled {
+		return nil, &MCPError{Code: mcp.ErrorCodeMethodNotFound, Message: "roots not supported"}
+	}
+
+	roots := append([]mcp.Root{}, c.roots...)
+	return mcp.ListRootsResponse{Roots: roots}, nil
+}
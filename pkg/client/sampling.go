@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// SamplingHandler answers sampling/createMessage requests from the server,
+// typically by forwarding them to the host application's language model.
+//
+// Return an *MCPError to control the JSON-RPC error code sent back to the
+// server (e.g. when the user rejects the request); any other error is
+// reported as an internal error.
+type SamplingHandler interface {
+	CreateMessage(ctx context.Context, request *mcp.CreateMessageRequest) (*mcp.CreateMessageResponse, error)
+}
+
+// SamplingHandlerFunc adapts an ordinary function to a SamplingHandler
+type SamplingHandlerFunc func(ctx context.Context, request *mcp.CreateMessageRequest) (*mcp.CreateMessageResponse, error)
+
+// CreateMessage calls f(ctx, request)
+func (f SamplingHandlerFunc) CreateMessage(ctx context.Context, request *mcp.CreateMessageRequest) (*mcp.CreateMessageResponse, error) {
+	return f(ctx, request)
+}
+
+// handleCreateMessage dispatches a sampling/createMessage request to the configured handler
+func (c *Client) handleCreateMessage(ctx context.Context, request *mcp.Message) (interface{}, error) {
+	if c.samplingHandler == nil {
+		return nil, &MCPError{Code: mcp.ErrorCodeMethodNotFound, Message: "sampling not supported"}
+	}
+
+	var params mcp.CreateMessageRequest
+	if err := c.parseResult(request.Params, &params); err != nil {
+		return nil, &MCPError{Code: mcp.ErrorCodeInvalidParams, Message: err.Error()}
+	}
+
+	result, err := c.samplingHandler.CreateMessage(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, &MCPError{Code: mcp.ErrorCodeInternalError, Message: "sampling handler returned no result"}
+	}
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/jsonschema"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// WithOutputSchema validates the call's structured content against schema,
+// overriding the output schema learned from ListTools
+func WithOutputSchema(schema map[string]interface{}) CallToolOption {
+	return func(o *callToolOptions) {
+		o.outputSchema = schema
+	}
+}
+
+// validateStructuredContent checks a tool result against the tool's output schema.
+// Error results are not validated: their content describes the failure instead.
+func (c *Client) validateStructuredContent(name string, result *mcp.CallToolResponse, schema map[string]interface{}) error {
+	if schema == nil {
+		schema = c.toolSchemas.output(name)
+	}
+	if schema == nil || result.IsError {
+		return nil
+	}
+
+	// Tools with an output schema must return structured content
+	if result.StructuredContent == nil {
+		return fmt.Errorf("%w: tool %q has an output schema but returned no structured content", ErrInvalidResponse, name)
+	}
+
+	if err := jsonschema.Validate(schema, result.StructuredContent); err != nil {
+		return fmt.Errorf("tool %q returned invalid structured content: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// resourceUpdateBuffer is how many undelivered updates a subscription holds
+// before further updates are dropped
+const resourceUpdateBuffer = 16
+
+// ResourceSubscription delivers notifications/resources/updated events for one resource URI.
+//
+// Subscriptions survive Disconnect: after the next successful Initialize the
+// client subscribes to the resource again. Call Close to stop receiving updates.
+type ResourceSubscription struct {
+	uri     string
+	client  *Client
+	updates chan mcp.ResourceUpdatedNotification
+}
+
+// URI returns the subscribed resource URI
+func (s *ResourceSubscription) URI() string {
+	return s.uri
+}
+
+// Updates returns the channel of update events. An update only signals that
+// the resource changed; read it again to get the new contents. If the
+// consumer falls behind, updates beyond the buffer are dropped, since a
+// pending update already means the resource must be re-read.
+//
+// The channel is closed when the subscription is closed.
+func (s *ResourceSubscription) Updates() <-chan mcp.ResourceUpdatedNotification {
+	return s.updates
+}
+
+// Close ends the subscription, unsubscribing from the server when it was
+// the last subscription for the URI. Calling Close more than once is safe.
+func (s *ResourceSubscription) Close(ctx context.Context) error {
+	last, removed := s.client.subscriptions.remove(s)
+	if !removed || !last {
+		return nil
+	}
+	return s.client.sendUnsubscribe(ctx, s.uri)
+}
+
+// resourceSubscriptions holds the active subscriptions keyed by URI
+type resourceSubscriptions struct {
+	mu    sync.Mutex
+	byURI map[string][]*ResourceSubscription
+}
+
+// add registers a subscription and reports whether it is the first for its URI
+func (r *resourceSubscriptions) add(sub *ResourceSubscription) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.byURI == nil {
+		r.byURI = make(map[string][]*ResourceSubscription)
+	}
+	r.byURI[sub.uri] = append(r.byURI[sub.uri], sub)
+	return len(r.byURI[sub.uri]) == 1
+}
+
+// remove unregisters and closes a subscription, reporting whether it was the
+// last for its URI and whether it was still registered
+func (r *resourceSubscriptions) remove(sub *ResourceSubscription) (last, removed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subs := r.byURI[sub.uri]
+	for i, existing := range subs {
+		if existing == sub {
+			subs = append(subs[:i:i], subs[i+1:]...)
+			removed = true
+			break
+		}
+	}
+	if !removed {
+		return false, false
+	}
+
+	close(sub.updates)
+	if len(subs) == 0 {
+		delete(r.byURI, sub.uri)
+		return true, true
+	}
+	r.byURI[sub.uri] = subs
+	return false, true
+}
+
+// removeURI unregisters and closes every subscription for a URI, reporting whether there were any
+func (r *resourceSubscriptions) removeURI(uri string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subs := r.byURI[uri]
+	for _, sub := range subs {
+		close(sub.updates)
+	}
+	delete(r.byURI, uri)
+	return len(subs) > 0
+}
+
+// uris returns the URIs with at least one subscription
+func (r *resourceSubscriptions) uris() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uris := make([]string, 0, len(r.byURI))
+	for uri := range r.byURI {
+		uris = append(uris, uri)
+	}
+	return uris
+}
+
+// deliver sends an update to every subscription for its URI without blocking,
+// returning how many subscriptions dropped it because their buffer was full
+func (r *resourceSubscriptions) deliver(update mcp.ResourceUpdatedNotification) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dropped := 0
+	for _, sub := range r.byURI[update.URI] {
+		select {
+		case sub.updates <- update:
+		default:
+			dropped++
+		}
+	}
+	return dropped
+}
+
+// SubscribeResource subscribes to change notifications for a resource.
+//
+// The server must advertise the resources.subscribe capability. Several
+// subscriptions to the same URI share one server-side subscription.
+//
+// Example:
+//
+//	sub, err := client.SubscribeResource(ctx, "file:///config.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	defer sub.Close(context.Background())
+//	for range sub.Updates() {
+//		contents, _ := client.ReadResource(ctx, sub.URI())
+//		...
+//	}
+func (c *Client) SubscribeResource(ctx context.Context, uri string) (*ResourceSubscription, error) {
+	if !c.IsInitialized() {
+		return nil, ErrNotInitialized
+	}
+	if caps := c.GetServerCapabilities(); caps == nil || caps.Resources == nil || !caps.Resources.Subscribe {
+		return nil, fmt.Errorf("server does not support resource subscriptions: %w", ErrCapabilityNotSupported)
+	}
+
+	sub := &ResourceSubscription{
+		uri:     uri,
+		client:  c,
+		updates: make(chan mcp.ResourceUpdatedNotification, resourceUpdateBuffer),
+	}
+	if !c.subscriptions.add(sub) {
+		return sub, nil
+	}
+
+	if err := c.sendSubscribe(ctx, uri); err != nil {
+		c.subscriptions.remove(sub)
+		return nil, err
+	}
+	return sub, nil
+}
+
+// UnsubscribeResource ends every subscription to a resource, closing their update channels
+func (c *Client) UnsubscribeResource(ctx context.Context, uri string) error {
+	if !c.subscriptions.removeURI(uri) {
+		return nil
+	}
+	return c.sendUnsubscribe(ctx, uri)
+}
+
+// sendSubscribe sends resources/subscribe for a URI
+func (c *Client) sendSubscribe(ctx context.Context, uri string) error {
+	c.log().Debug("subscribing to resource", "uri", uri)
+
+	response, err := c.sendRequest(ctx, "resources/subscribe", mcp.SubscribeRequest{URI: uri})
+	if err != nil {
+		return fmt.Errorf("subscribe request failed: %w", err)
+	}
+	if response.Error != nil {
+		return fmt.Errorf("subscribe error: %w", newMCPError(response.Error))
+	}
+	return nil
+}
+
+// sendUnsubscribe sends resources/unsubscribe for a URI, skipping it when the
+// connection is gone since the server-side subscription went with it
+func (c *Client) sendUnsubscribe(ctx context.Context, uri string) error {
+	if !c.IsInitialized() {
+		return nil
+	}
+	c.log().Debug("unsubscribing from resource", "uri", uri)
+
+	response, err := c.sendRequest(ctx, "resources/unsubscribe", mcp.UnsubscribeRequest{URI: uri})
+	if err != nil {
+		return fmt.Errorf("unsubscribe request failed: %w", err)
+	}
+	if response.Error != nil {
+		return fmt.Errorf("unsubscribe error: %w", newMCPError(response.Error))
+	}
+	return nil
+}
+
+// resubscribeResources restores the server-side subscriptions after a new Initialize
+func (c *Client) resubscribeResources(ctx context.Context) {
+	uris := c.subscriptions.uris()
+	if len(uris) == 0 {
+		return
+	}
+	if caps := c.GetServerCapabilities(); caps == nil || caps.Resources == nil || !caps.Resources.Subscribe {
+		c.log().Warn("server no longer supports resource subscriptions; subscriptions will not receive updates", "subscriptions", len(uris))
+		return
+	}
+
+	for _, uri := range uris {
+		if err := c.sendSubscribe(ctx, uri); err != nil {
+			c.log().Warn("failed to restore resource subscription", "uri", uri, "error", err)
+		}
+	}
+}
+
+// routeResourceUpdate delivers notifications/resources/updated to matching subscriptions
+func (c *Client) routeResourceUpdate(notification *mcp.Message) {
+	var update mcp.ResourceUpdatedNotification
+	if !c.parseNotification(notification, &update) {
+		return
+	}
+	if dropped := c.subscriptions.deliver(update); dropped > 0 {
+		c.log().Warn("dropped resource update for slow subscribers", "uri", update.URI, "subscribers", dropped)
+	}
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/tracing"
+)
+
+// Span attributes recorded by TracingInterceptor
+const (
+	AttrMethod       = "mcp.method"
+	AttrToolName     = "mcp.tool.name"
+	AttrPromptName   = "mcp.prompt.name"
+	AttrResourceURI  = "mcp.resource.uri"
+	AttrRequestID    = "mcp.request.id"
+	AttrRequestSize  = "mcp.request.size"  // Bytes of JSON params
+	AttrResponseSize = "mcp.response.size" // Bytes of JSON result or error
+	AttrOutcome      = "mcp.outcome"       // "ok", "tool_error", "error" or "failed"
+	AttrErrorCode    = "mcp.error.code"    // JSON-RPC error code
+)
+
+// TracingInterceptor opens a span for every request, as a child of the span
+// context carried by the request's context (see tracing.ContextWithSpanContext).
+//
+// The span's trace context is sent to the server as traceparent and
+// tracestate in the request's _meta; the HTTP transports also send it as
+// headers. Spans are named after the method and, where there is one, the
+// tool, prompt or resource it targets, e.g. "tools/call search".
+func TracingInterceptor(tracer *tracing.Tracer) RequestInterceptor {
+	return func(ctx context.Context, req *Request, next RequestInvoker) (*mcp.Message, error) {
+		ctx, span := tracer.Start(ctx, req.Method)
+		defer span.End()
+
+		sc := span.Context()
+		if err := req.SetMeta(tracing.TraceparentKey, sc.Traceparent()); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		if sc.TraceState != "" {
+			if err := req.SetMeta(tracing.TracestateKey, sc.TraceState); err != nil {
+				span.RecordError(err)
+				return nil, err
+			}
+		}
+
+		span.SetAttribute(AttrMethod, req.Method)
+		if attr, target := spanTarget(req); target != "" {
+			span.SetAttribute(attr, target)
+			span.SetName(req.Method + " " + target)
+		}
+		span.SetAttribute(AttrRequestSize, jsonSize(req.Params))
+
+		response, err := next(ctx, req)
+
+		if req.ID != 0 {
+			span.SetAttribute(AttrRequestID, req.ID)
+		}
+		switch {
+		case err != nil:
+			span.SetAttribute(AttrOutcome, "failed")
+			span.RecordError(err)
+		case response == nil:
+			span.SetAttribute(AttrOutcome, "failed")
+			span.SetStatus(tracing.StatusError, "no response")
+		case response.Error != nil:
+			span.SetAttribute(AttrOutcome, "error")
+			span.SetAttribute(AttrErrorCode, response.Error.Code)
+			span.SetAttribute(AttrResponseSize, jsonSize(response.Error))
+			span.SetStatus(tracing.StatusError, response.Error.Message)
+		default:
+			span.SetAttribute(AttrResponseSize, jsonSize(response.Result))
+			if result, ok := response.Result.(map[string]interface{}); ok && result["isError"] == true {
+				span.SetAttribute(AttrOutcome, "tool_error")
+				span.SetStatus(tracing.StatusError, "tool reported an error")
+			} else {
+				span.SetAttribute(AttrOutcome, "ok")
+				span.SetStatus(tracing.StatusOK, "")
+			}
+		}
+		return response, err
+	}
+}
+
+// spanTarget returns the tool, prompt or resource a request is about, with its attribute
+func spanTarget(req *Request) (attr, target string) {
+	params, _ := req.Params.(map[string]interface{})
+	switch req.Method {
+	case "tools/call":
+		target, _ = params["name"].(string)
+		return AttrToolName, target
+	case "prompts/get":
+		target, _ = params["name"].(string)
+		return AttrPromptName, target
+	case "resources/read", "resources/subscribe", "resources/unsubscribe":
+		target, _ = params["uri"].(string)
+		return AttrResourceURI, target
+	}
+	return "", ""
+}
+
+// jsonSize returns the length of v's JSON encoding, or 0 if it can't be encoded
+func jsonSize(v interface{}) int {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// CallToolAs calls a tool with arguments encoded from in and decodes the
+// result into Out.
+//
+// In must encode to a JSON object, e.g. a struct with json tags or a map;
+// a nil pointer or map sends no arguments. The result is decoded from the
+// tool's structured content if present, otherwise from the first text content
+// that holds JSON (or the raw text when Out is a string). A result with isError
+// set is returned as a *ToolError.
+//
+// Example:
+//
+//	type SearchArgs struct {
+//		Query string `json:"query"`
+//		Limit int    `json:"limit,omitempty"`
+//	}
+//	type SearchResult struct {
+//		Hits []string `json:"hits"`
+//	}
+//	result, err := client.CallToolAs[SearchArgs, SearchResult](ctx, c, "search", SearchArgs{Query: "golang"})
+func CallToolAs[In, Out any](ctx context.Context, c *Client, name string, in In, opts ...CallToolOption) (Out, error) {
+	var out Out
+
+	arguments, err := toolArguments(in)
+	if err != nil {
+		return out, fmt.Errorf("failed to encode arguments for tool %q: %w", name, err)
+	}
+
+	result, err := c.CallTool(ctx, name, arguments, opts...)
+	if err != nil {
+		return out, err
+	}
+	return decodeToolResult[Out](name, result)
+}
+
+// toolArguments converts a value to the arguments object of a tools/call request
+func toolArguments(in interface{}) (map[string]interface{}, error) {
+	if arguments, ok := in.(map[string]interface{}); ok {
+		return arguments, nil
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	if string(data) == "null" {
+		return nil, nil
+	}
+
+	var arguments map[string]interface{}
+	if err := json.Unmarshal(data, &arguments); err != nil {
+		return nil, fmt.Errorf("arguments must encode to a JSON object, got %s", data)
+	}
+	return arguments, nil
+}
+
+// decodeToolResult extracts the typed value from a tool result
+func decodeToolResult[Out any](name string, result *mcp.CallToolResponse) (Out, error) {
+	var out Out
+
+	if result.IsError {
+		return out, &ToolError{Tool: name, Content: result.Content}
+	}
+
+	if result.StructuredContent != nil {
+		if err := result.DecodeStructuredContent(&out); err != nil {
+			return out, fmt.Errorf("%w: tool %q: %w", ErrInvalidResponse, name, err)
+		}
+		return out, nil
+	}
+
+	var lastErr error
+	for _, content := range result.Content {
+		if content.Type != "text" {
+			continue
+		}
+		err := json.Unmarshal([]byte(content.Text), &out)
+		if err == nil {
+			return out, nil
+		}
+		// Plain text is only usable as is when a string was asked for
+		if text, ok := interface{}(&out).(*string); ok {
+			*text = content.Text
+			return out, nil
+		}
+		lastErr = err
+	}
+
+	if lastErr != nil {
+		return out, fmt.Errorf("%w: tool %q returned text that does not decode into %T: %w", ErrInvalidResponse, name, out, lastErr)
+	}
+	return out, fmt.Errorf("%w: tool %q returned no structured or text content to decode into %T", ErrInvalidResponse, name, out)
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/jsonschema"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// toolSchemaCache remembers the input and output schemas of tools seen in tools/list
+type toolSchemaCache struct {
+	mu      sync.RWMutex
+	inputs  map[string]map[string]interface{}
+	outputs map[string]map[string]interface{}
+}
+
+// remember records (or forgets) the schemas of each tool
+func (t *toolSchemaCache) remember(tools []mcp.Tool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.inputs == nil {
+		t.inputs = make(map[string]map[string]interface{})
+		t.outputs = make(map[string]map[string]interface{})
+	}
+	for _, tool := range tools {
+		setOrDelete(t.inputs, tool.Name, tool.InputSchema)
+		setOrDelete(t.outputs, tool.Name, tool.OutputSchema)
+	}
+}
+
+func setOrDelete(schemas map[string]map[string]interface{}, name string, schema map[string]interface{}) {
+	if schema != nil {
+		schemas[name] = schema
+	} else {
+		delete(schemas, name)
+	}
+}
+
+// replace forgets every schema and records those of tools, a complete
+// tools/list result, so tools the server dropped are forgotten too
+func (t *toolSchemaCache) replace(tools []mcp.Tool) {
+	t.clear()
+	t.remember(tools)
+}
+
+// input returns the remembered input schema for a tool, if any
+func (t *toolSchemaCache) input(name string) map[string]interface{} {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.inputs[name]
+}
+
+// output returns the remembered output schema for a tool, if any
+func (t *toolSchemaCache) output(name string) map[string]interface{} {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.outputs[name]
+}
+
+// clear forgets every schema, e.g. when disconnecting from a server or when
+// the server's tools change
+func (t *toolSchemaCache) clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inputs = nil
+	t.outputs = nil
+}
+
+// validateToolArguments checks arguments against the tool's input schema
+// before they are sent. The schema is known once the tool was listed; with
+// CacheCatalog enabled the catalog is loaded to find it.
+func (c *Client) validateToolArguments(ctx context.Context, name string, arguments map[string]interface{}) error {
+	if !c.validateArguments {
+		return nil
+	}
+
+	schema := c.toolSchemas.input(name)
+	if schema == nil && c.catalog.enabled {
+		if tool, err := c.Tool(ctx, name); err == nil {
+			schema = tool.InputSchema
+		}
+	}
+	if schema == nil {
+		return nil
+	}
+
+	// Validate what the server will receive: omitted arguments are an empty
+	// object, and Go values are in their JSON form
+	var value interface{} = map[string]interface{}{}
+	if arguments != nil {
+		normalized, err := jsonschema.Normalize(arguments)
+		if err != nil {
+			return fmt.Errorf("failed to encode arguments for tool %q: %w", name, err)
+		}
+		value = normalized
+	}
+
+	if err := jsonschema.Validate(schema, value); err != nil {
+		return fmt.Errorf("invalid arguments for tool %q: %w", name, err)
+	}
+	return nil
+}
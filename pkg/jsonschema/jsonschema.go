@@ -0,0 +1,504 @@
+// Package jsonschema validates JSON values against the subset of JSON Schema
+// used by MCP tool input and output schemas.
+//
+// Values must be in the form produced by encoding/json when decoding into
+// interface{}: map[string]interface{}, []interface{}, string, float64, bool
+// and nil. Use Normalize to convert arbitrary Go values first.
+//
+// Supported keywords:
+//
+//   - any value: type, enum, const, allOf, anyOf, oneOf, not and $ref
+//     (local references such as "#/$defs/address")
+//   - numbers: minimum, maximum, exclusiveMinimum, exclusiveMaximum and multipleOf
+//   - strings: minLength, maxLength and pattern
+//   - arrays: items, minItems, maxItems and uniqueItems
+//   - objects: properties, required, additionalProperties, minProperties and maxProperties
+//
+// Unknown keywords, including format, are ignored, as the JSON Schema
+// specification requires. Patterns use Go's regexp syntax; patterns it cannot
+// compile (such as lookaheads) are ignored rather than rejecting every value.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// FieldError describes one validation failure
+type FieldError struct {
+	Pointer string // JSON pointer (RFC 6901) to the failing value; "" is the root
+	Message string
+}
+
+// Error implements error
+func (e FieldError) Error() string {
+	pointer := e.Pointer
+	if pointer == "" {
+		pointer = "/"
+	}
+	return pointer + ": " + e.Message
+}
+
+// ValidationError holds every failure found while validating a value
+type ValidationError struct {
+	Errors []FieldError
+}
+
+// Error implements error
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		messages[i] = fieldErr.Error()
+	}
+	return "schema validation failed: " + strings.Join(messages, "; ")
+}
+
+// Validate checks value against schema, returning a *ValidationError listing
+// every failure, or nil if the value is valid. A nil or empty schema accepts anything.
+func Validate(schema map[string]interface{}, value interface{}) error {
+	v := &validator{root: schema}
+	v.validate(schema, value, "")
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: v.errors}
+}
+
+// Normalize converts a Go value to its generic JSON form by round-tripping it through encoding/json
+func Normalize(value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// maxRefDepth bounds how many $refs may be followed without descending into
+// the value, so a self-referencing schema can't recurse forever
+const maxRefDepth = 32
+
+// validator accumulates failures during one Validate call
+type validator struct {
+	root     map[string]interface{} // Schema that $refs are resolved against
+	errors   []FieldError
+	refDepth int
+}
+
+func (v *validator) fail(pointer, format string, args ...interface{}) {
+	v.errors = append(v.errors, FieldError{Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) validate(schema map[string]interface{}, value interface{}, pointer string) {
+	if len(schema) == 0 {
+		return
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		v.validateRef(ref, value, pointer)
+	}
+
+	if !v.checkType(schema, value, pointer) {
+		return // Other keywords would only repeat the type mismatch
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, option := range enum {
+			if equal(option, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.fail(pointer, "must be one of %s", formatValues(enum))
+		}
+	}
+
+	if constant, ok := schema["const"]; ok && !equal(constant, value) {
+		v.fail(pointer, "must equal %s", formatValue(constant))
+	}
+
+	v.validateCombinators(schema, value, pointer)
+
+	switch val := value.(type) {
+	case map[string]interface{}:
+		v.validateObject(schema, val, pointer)
+	case []interface{}:
+		v.validateArray(schema, val, pointer)
+	case string:
+		v.validateString(schema, val, pointer)
+	case float64:
+		v.validateNumber(schema, val, pointer)
+	}
+}
+
+// validateRef validates value against the schema a local $ref points to
+func (v *validator) validateRef(ref string, value interface{}, pointer string) {
+	target, ok := resolveRef(v.root, ref)
+	if !ok {
+		v.fail(pointer, "schema reference %q cannot be resolved", ref)
+		return
+	}
+	if v.refDepth >= maxRefDepth {
+		v.fail(pointer, "schema reference %q is too deeply nested", ref)
+		return
+	}
+	v.refDepth++
+	v.validate(target, value, pointer)
+	v.refDepth--
+}
+
+// validateCombinators checks allOf, anyOf, oneOf and not
+func (v *validator) validateCombinators(schema map[string]interface{}, value interface{}, pointer string) {
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			if subSchema, ok := sub.(map[string]interface{}); ok {
+				v.validate(subSchema, value, pointer)
+			}
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		if v.countMatches(anyOf, value, pointer) == 0 {
+			v.fail(pointer, "must match at least one schema in anyOf")
+		}
+	}
+
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		if matches := v.countMatches(oneOf, value, pointer); matches != 1 {
+			v.fail(pointer, "must match exactly one schema in oneOf, matched %d", matches)
+		}
+	}
+
+	if not, ok := schema["not"].(map[string]interface{}); ok {
+		if v.matches(not, value, pointer) {
+			v.fail(pointer, "must not match the schema in not")
+		}
+	}
+}
+
+// countMatches returns how many of the schemas accept value
+func (v *validator) countMatches(schemas []interface{}, value interface{}, pointer string) int {
+	matches := 0
+	for _, sub := range schemas {
+		if subSchema, ok := sub.(map[string]interface{}); ok && v.matches(subSchema, value, pointer) {
+			matches++
+		}
+	}
+	return matches
+}
+
+// matches reports whether value is valid against schema without recording failures
+func (v *validator) matches(schema map[string]interface{}, value interface{}, pointer string) bool {
+	sub := &validator{root: v.root, refDepth: v.refDepth}
+	sub.validate(schema, value, pointer)
+	return len(sub.errors) == 0
+}
+
+// validateArray checks items, minItems, maxItems and uniqueItems
+func (v *validator) validateArray(schema map[string]interface{}, array []interface{}, pointer string) {
+	if minItems, ok := number(schema["minItems"]); ok && float64(len(array)) < minItems {
+		v.fail(pointer, "must have at least %s items, got %d", formatNumber(minItems), len(array))
+	}
+	if maxItems, ok := number(schema["maxItems"]); ok && float64(len(array)) > maxItems {
+		v.fail(pointer, "must have at most %s items, got %d", formatNumber(maxItems), len(array))
+	}
+	if unique, _ := schema["uniqueItems"].(bool); unique {
+		for i := 1; i < len(array); i++ {
+			for j := 0; j < i; j++ {
+				if equal(array[i], array[j]) {
+					v.fail(pointer+"/"+strconv.Itoa(i), "duplicates item %d", j)
+					break
+				}
+			}
+		}
+	}
+
+	// Each item is validated, so there may be one refDepth budget per level
+	saved := v.refDepth
+	v.refDepth = 0
+	defer func() { v.refDepth = saved }()
+
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		for i, item := range array {
+			v.validate(items, item, pointer+"/"+strconv.Itoa(i))
+		}
+	}
+}
+
+// validateString checks minLength, maxLength and pattern
+func (v *validator) validateString(schema map[string]interface{}, s string, pointer string) {
+	length := utf8.RuneCountInString(s) // JSON Schema counts characters, not bytes
+	if minLength, ok := number(schema["minLength"]); ok && float64(length) < minLength {
+		v.fail(pointer, "must be at least %s characters long", formatNumber(minLength))
+	}
+	if maxLength, ok := number(schema["maxLength"]); ok && float64(length) > maxLength {
+		v.fail(pointer, "must be at most %s characters long", formatNumber(maxLength))
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		if re := compilePattern(pattern); re != nil && !re.MatchString(s) {
+			v.fail(pointer, "must match pattern %q", pattern)
+		}
+	}
+}
+
+// validateNumber checks minimum, maximum, their exclusive forms and multipleOf
+func (v *validator) validateNumber(schema map[string]interface{}, n float64, pointer string) {
+	// Draft 4 spells exclusive bounds as booleans modifying minimum and maximum
+	exclusiveMin, _ := schema["exclusiveMinimum"].(bool)
+	exclusiveMax, _ := schema["exclusiveMaximum"].(bool)
+
+	if minimum, ok := number(schema["minimum"]); ok {
+		if exclusiveMin && n <= minimum {
+			v.fail(pointer, "must be greater than %s", formatNumber(minimum))
+		} else if n < minimum {
+			v.fail(pointer, "must be at least %s", formatNumber(minimum))
+		}
+	}
+	if maximum, ok := number(schema["maximum"]); ok {
+		if exclusiveMax && n >= maximum {
+			v.fail(pointer, "must be less than %s", formatNumber(maximum))
+		} else if n > maximum {
+			v.fail(pointer, "must be at most %s", formatNumber(maximum))
+		}
+	}
+	if bound, ok := number(schema["exclusiveMinimum"]); ok && n <= bound {
+		v.fail(pointer, "must be greater than %s", formatNumber(bound))
+	}
+	if bound, ok := number(schema["exclusiveMaximum"]); ok && n >= bound {
+		v.fail(pointer, "must be less than %s", formatNumber(bound))
+	}
+	if divisor, ok := number(schema["multipleOf"]); ok && divisor > 0 {
+		quotient := n / divisor
+		if math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+			v.fail(pointer, "must be a multiple of %s", formatNumber(divisor))
+		}
+	}
+}
+
+// validateObject checks required, properties, additionalProperties,
+// minProperties and maxProperties
+func (v *validator) validateObject(schema map[string]interface{}, object map[string]interface{}, pointer string) {
+	if minProperties, ok := number(schema["minProperties"]); ok && float64(len(object)) < minProperties {
+		v.fail(pointer, "must have at least %s properties", formatNumber(minProperties))
+	}
+	if maxProperties, ok := number(schema["maxProperties"]); ok && float64(len(object)) > maxProperties {
+		v.fail(pointer, "must have at most %s properties", formatNumber(maxProperties))
+	}
+
+	// Each property is validated, so there may be one refDepth budget per level
+	saved := v.refDepth
+	v.refDepth = 0
+	defer func() { v.refDepth = saved }()
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			key, _ := name.(string)
+			if _, present := object[key]; !present {
+				v.fail(pointer+"/"+escapePointer(key), "is required")
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys) // Report failures in a stable order
+
+	for _, key := range keys {
+		child := pointer + "/" + escapePointer(key)
+		if propSchema, ok := properties[key].(map[string]interface{}); ok {
+			v.validate(propSchema, object[key], child)
+			continue
+		}
+		if _, declared := properties[key]; declared {
+			continue
+		}
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				v.fail(child, "is not an allowed property")
+			}
+		case map[string]interface{}:
+			v.validate(additional, object[key], child)
+		}
+	}
+}
+
+// checkType validates the type keyword, which may be a string or a list of strings
+func (v *validator) checkType(schema map[string]interface{}, value interface{}, pointer string) bool {
+	var types []string
+	switch t := schema["type"].(type) {
+	case string:
+		types = []string{t}
+	case []interface{}:
+		for _, name := range t {
+			if s, ok := name.(string); ok {
+				types = append(types, s)
+			}
+		}
+	default:
+		return true
+	}
+
+	for _, name := range types {
+		if hasType(value, name) {
+			return true
+		}
+	}
+	v.fail(pointer, "expected %s, got %s", strings.Join(types, " or "), typeOf(value))
+	return false
+}
+
+// hasType reports whether value is an instance of the named JSON Schema type
+func hasType(value interface{}, name string) bool {
+	switch name {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == math.Trunc(n) && !math.IsInf(n, 0)
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	}
+	return true // Unknown type names don't constrain the value
+}
+
+// typeOf names the JSON type of a value for error messages
+func typeOf(value interface{}) string {
+	switch n := value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		if n == math.Trunc(n) {
+			return "integer"
+		}
+		return "number"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// equal compares two generic JSON values
+func equal(a, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+func formatValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+func formatValues(values []interface{}) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = formatValue(value)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// number returns a schema keyword's numeric value
+func number(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// patterns caches compiled patterns; nil marks a pattern Go cannot compile
+var patterns sync.Map
+
+// compilePattern compiles a pattern once, returning nil if it is not valid Go regexp syntax
+func compilePattern(pattern string) *regexp.Regexp {
+	if cached, ok := patterns.Load(pattern); ok {
+		return cached.(*regexp.Regexp)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = nil
+	}
+	patterns.Store(pattern, re)
+	return re
+}
+
+// resolveRef finds the schema a local reference such as "#/$defs/name" points to
+func resolveRef(root map[string]interface{}, ref string) (map[string]interface{}, bool) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, false // Remote references are not supported
+	}
+
+	var current interface{} = root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch node := current.(type) {
+		case map[string]interface{}:
+			current = node[token]
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			current = node[i]
+		default:
+			return nil, false
+		}
+	}
+
+	schema, ok := current.(map[string]interface{})
+	return schema, ok
+}
+
+// escapePointer escapes a property name for use in a JSON pointer
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
@@ -1,11 +1,59 @@
 package mcp
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/uritemplate"
 )
 
-// MCP Protocol Version
-const Version = "2024-11-05"
+// MCP protocol versions understood by this library
+const (
+	ProtocolVersion20241105 = "2024-11-05"
+	ProtocolVersion20250326 = "2025-03-26"
+	ProtocolVersion20250618 = "2025-06-18"
+	ProtocolVersion20251125 = "2025-11-25"
+)
+
+// LatestProtocolVersion is the newest protocol version this library supports
+const LatestProtocolVersion = ProtocolVersion20251125
+
+// Version is the protocol version requested during initialization by default
+const Version = LatestProtocolVersion
+
+// SupportedProtocolVersions lists the protocol versions this library can negotiate, newest first
+var SupportedProtocolVersions = []string{
+	ProtocolVersion20251125,
+	ProtocolVersion20250618,
+	ProtocolVersion20250326,
+	ProtocolVersion20241105,
+}
+
+// IsSupportedProtocolVersion reports whether version is in SupportedProtocolVersions
+func IsSupportedProtocolVersion(version string) bool {
+	for _, supported := range SupportedProtocolVersions {
+		if version == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// ProtocolVersionAtLeast reports whether version is the same as or newer than minimum.
+// Protocol versions are dates, so they order lexically.
+func ProtocolVersionAtLeast(version, minimum string) bool {
+	return version >= minimum
+}
+
+// ProtocolVersionSupportsBatching reports whether version allows JSON-RPC
+// batches. Batching was added in 2025-03-26 and removed again in 2025-06-18.
+func ProtocolVersionSupportsBatching(version string) bool {
+	return version == ProtocolVersion20250326
+}
 
 // Message Types
 type MessageType string
@@ -26,6 +74,33 @@ type Message struct {
 	Error   *ErrorInfo  `json:"error,omitempty"`
 }
 
+// DecodeMessages decodes a single JSON-RPC message or a batch of them sent
+// as a JSON array, returning the messages in order
+func DecodeMessages(data []byte) ([]*Message, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var batch []*Message
+		if err := json.Unmarshal(data, &batch); err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			return nil, fmt.Errorf("empty batch")
+		}
+		for i, message := range batch {
+			if message == nil {
+				return nil, fmt.Errorf("batch item %d is null", i)
+			}
+		}
+		return batch, nil
+	}
+
+	var message Message
+	if err := json.Unmarshal(data, &message); err != nil {
+		return nil, err
+	}
+	return []*Message{&message}, nil
+}
+
 // Error Information
 type ErrorInfo struct {
 	Code    int         `json:"code"`
@@ -37,9 +112,15 @@ type ErrorInfo struct {
 type ClientCapabilities struct {
 	Experimental map[string]interface{} `json:"experimental,omitempty"`
 	Sampling     *SamplingCapability    `json:"sampling,omitempty"`
+	Roots        *RootsCapability       `json:"roots,omitempty"`
+	Elicitation  *ElicitationCapability `json:"elicitation,omitempty"`
 }
 
 type SamplingCapability struct{}
+type RootsCapability struct {
+	ListChanged bool `json:"listChanged,omitempty"`
+}
+type ElicitationCapability struct{}
 
 // Server Capabilities
 type ServerCapabilities struct {
@@ -48,6 +129,7 @@ type ServerCapabilities struct {
 	Prompts      *PromptsCapability     `json:"prompts,omitempty"`
 	Resources    *ResourcesCapability   `json:"resources,omitempty"`
 	Tools        *ToolsCapability       `json:"tools,omitempty"`
+	Completions  *CompletionsCapability `json:"completions,omitempty"`
 }
 
 type LoggingCapability struct{}
@@ -61,6 +143,7 @@ type ResourcesCapability struct {
 type ToolsCapability struct {
 	ListChanged bool `json:"listChanged,omitempty"`
 }
+type CompletionsCapability struct{}
 
 // Icon represents a tool or resource icon
 type Icon struct {
@@ -117,11 +200,32 @@ type ListToolsResponse struct {
 type CallToolRequest struct {
 	Name      string                 `json:"name"`
 	Arguments map[string]interface{} `json:"arguments,omitempty"`
+	Meta      map[string]interface{} `json:"_meta,omitempty"` // Request metadata, e.g. progressToken
 }
 
 type CallToolResponse struct {
 	Content []Content `json:"content"`
 	IsError bool      `json:"isError,omitempty"`
+
+	// StructuredContent is the tool's typed result, described by the tool's
+	// OutputSchema (protocol version 2025-06-18 and later)
+	StructuredContent map[string]interface{} `json:"structuredContent,omitempty"`
+}
+
+// DecodeStructuredContent decodes the structured result into target, which
+// must be a pointer (typically to a struct matching the tool's OutputSchema)
+func (r *CallToolResponse) DecodeStructuredContent(target interface{}) error {
+	if r.StructuredContent == nil {
+		return fmt.Errorf("tool result has no structured content")
+	}
+	data, err := json.Marshal(r.StructuredContent)
+	if err != nil {
+		return fmt.Errorf("failed to encode structured content: %w", err)
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("failed to decode structured content: %w", err)
+	}
+	return nil
 }
 
 // Content Types
@@ -144,10 +248,52 @@ type Resource struct {
 	Annotations map[string]interface{} `json:"annotations,omitempty"`
 }
 
-type ListResourcesRequest struct{}
+// ListResourcesRequest supports optional pagination via cursor
+type ListResourcesRequest struct {
+	Cursor string `json:"cursor,omitempty"`
+}
 
 type ListResourcesResponse struct {
-	Resources []Resource `json:"resources"`
+	Resources  []Resource `json:"resources"`
+	NextCursor string     `json:"nextCursor,omitempty"`
+}
+
+// ResourceTemplate describes a parameterized resource whose URI is built
+// from an RFC 6570 URI template, e.g. "file:///{+path}"
+type ResourceTemplate struct {
+	URITemplate string                 `json:"uriTemplate"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	MimeType    string                 `json:"mimeType,omitempty"`
+	Annotations map[string]interface{} `json:"annotations,omitempty"`
+}
+
+// Variables returns the names of the template's variables
+func (t ResourceTemplate) Variables() ([]string, error) {
+	tmpl, err := uritemplate.Parse(t.URITemplate)
+	if err != nil {
+		return nil, err
+	}
+	return tmpl.Variables(), nil
+}
+
+// Expand fills the template's variables to produce a concrete resource URI
+func (t ResourceTemplate) Expand(vars map[string]interface{}) (string, error) {
+	tmpl, err := uritemplate.Parse(t.URITemplate)
+	if err != nil {
+		return "", err
+	}
+	return tmpl.Expand(vars)
+}
+
+// ListResourceTemplatesRequest supports optional pagination via cursor
+type ListResourceTemplatesRequest struct {
+	Cursor string `json:"cursor,omitempty"`
+}
+
+type ListResourceTemplatesResponse struct {
+	ResourceTemplates []ResourceTemplate `json:"resourceTemplates"`
+	NextCursor        string             `json:"nextCursor,omitempty"`
 }
 
 // Prompt Definitions
@@ -163,10 +309,14 @@ type PromptArgument struct {
 	Required    bool   `json:"required,omitempty"`
 }
 
-type ListPromptsRequest struct{}
+// ListPromptsRequest supports optional pagination via cursor
+type ListPromptsRequest struct {
+	Cursor string `json:"cursor,omitempty"`
+}
 
 type ListPromptsResponse struct {
-	Prompts []Prompt `json:"prompts"`
+	Prompts    []Prompt `json:"prompts"`
+	NextCursor string   `json:"nextCursor,omitempty"`
 }
 
 // GetPrompt request/response types
@@ -194,6 +344,268 @@ type ReadResourceResponse struct {
 	Contents []Content `json:"contents"`
 }
 
+// SubscribeRequest asks the server to send notifications/resources/updated for a resource
+type SubscribeRequest struct {
+	URI string `json:"uri"`
+}
+
+// UnsubscribeRequest cancels a previous SubscribeRequest
+type UnsubscribeRequest struct {
+	URI string `json:"uri"`
+}
+
+// Completion request/response types
+
+// Completion reference types
+const (
+	CompletionRefPrompt   = "ref/prompt"
+	CompletionRefResource = "ref/resource"
+)
+
+// CompletionReference identifies the prompt or resource template whose argument is being completed
+type CompletionReference struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"` // Prompt name for ref/prompt
+	URI  string `json:"uri,omitempty"`  // URI template for ref/resource
+}
+
+// PromptReference creates a completion reference to a prompt
+func PromptReference(name string) CompletionReference {
+	return CompletionReference{Type: CompletionRefPrompt, Name: name}
+}
+
+// ResourceTemplateReference creates a completion reference to a resource template
+func ResourceTemplateReference(uriTemplate string) CompletionReference {
+	return CompletionReference{Type: CompletionRefResource, URI: uriTemplate}
+}
+
+// CompletionArgument is the argument being completed and its partial value
+type CompletionArgument struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CompletionContext carries the values of previously filled arguments
+type CompletionContext struct {
+	Arguments map[string]string `json:"arguments,omitempty"`
+}
+
+type CompleteRequest struct {
+	Ref      CompletionReference `json:"ref"`
+	Argument CompletionArgument  `json:"argument"`
+	Context  *CompletionContext  `json:"context,omitempty"`
+}
+
+// Completion holds the suggested values; Total and HasMore describe values beyond those returned
+type Completion struct {
+	Values  []string `json:"values"`
+	Total   int      `json:"total,omitempty"`
+	HasMore bool     `json:"hasMore,omitempty"`
+}
+
+type CompleteResponse struct {
+	Completion Completion `json:"completion"`
+}
+
+// Sampling request/response types (sent by the server to the client)
+
+// Stop reasons reported in CreateMessageResponse
+const (
+	StopReasonEndTurn      = "endTurn"
+	StopReasonStopSequence = "stopSequence"
+	StopReasonMaxTokens    = "maxTokens"
+)
+
+// SamplingMessage is a single conversation turn in a sampling request
+type SamplingMessage struct {
+	Role    string  `json:"role"` // "user" or "assistant"
+	Content Content `json:"content"`
+}
+
+// ModelHint suggests a model by (partial) name
+type ModelHint struct {
+	Name string `json:"name,omitempty"`
+}
+
+// ModelPreferences expresses the server's priorities when the client selects a model.
+// Priorities range from 0 to 1.
+type ModelPreferences struct {
+	Hints                []ModelHint `json:"hints,omitempty"`
+	CostPriority         *float64    `json:"costPriority,omitempty"`
+	SpeedPriority        *float64    `json:"speedPriority,omitempty"`
+	IntelligencePriority *float64    `json:"intelligencePriority,omitempty"`
+}
+
+// CreateMessageRequest asks the client to sample from its language model
+type CreateMessageRequest struct {
+	Messages         []SamplingMessage      `json:"messages"`
+	ModelPreferences *ModelPreferences      `json:"modelPreferences,omitempty"`
+	SystemPrompt     string                 `json:"systemPrompt,omitempty"`
+	IncludeContext   string                 `json:"includeContext,omitempty"` // "none", "thisServer" or "allServers"
+	Temperature      *float64               `json:"temperature,omitempty"`
+	MaxTokens        int                    `json:"maxTokens"`
+	StopSequences    []string               `json:"stopSequences,omitempty"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// CreateMessageResponse is the client's answer to a sampling request
+type CreateMessageResponse struct {
+	Role       string  `json:"role"`
+	Content    Content `json:"content"`
+	Model      string  `json:"model"`                // Name of the model that generated the message
+	StopReason string  `json:"stopReason,omitempty"` // One of the StopReason constants, or a provider-specific value
+}
+
+// Roots request/response types (sent by the server to the client)
+
+// Root is a directory or file the server is allowed to operate on
+type Root struct {
+	URI  string `json:"uri"` // MUST be a file:// URI
+	Name string `json:"name,omitempty"`
+}
+
+type ListRootsResponse struct {
+	Roots []Root `json:"roots"`
+}
+
+// NewFileRoot creates a Root for a local filesystem path, resolving it to an absolute file:// URI
+func NewFileRoot(path, name string) (Root, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return Root{}, fmt.Errorf("failed to resolve root path %q: %w", path, err)
+	}
+
+	slashed := filepath.ToSlash(abs)
+	if !strings.HasPrefix(slashed, "/") {
+		slashed = "/" + slashed // Windows drive paths, e.g. C:/work
+	}
+
+	uri := url.URL{Scheme: "file", Path: slashed}
+	return Root{URI: uri.String(), Name: name}, nil
+}
+
+// ValidateRoot checks that a root uses a file:// URI as required by the MCP specification
+func ValidateRoot(root Root) error {
+	u, err := url.Parse(root.URI)
+	if err != nil {
+		return fmt.Errorf("invalid root URI %q: %w", root.URI, err)
+	}
+	if u.Scheme != "file" {
+		return fmt.Errorf("root URI %q must use the file:// scheme", root.URI)
+	}
+	return nil
+}
+
+// Elicitation request/response types (sent by the server to the client)
+
+// Actions a user can take in response to an elicitation request
+const (
+	ElicitActionAccept  = "accept"  // User submitted the form
+	ElicitActionDecline = "decline" // User explicitly refused to provide the information
+	ElicitActionCancel  = "cancel"  // User dismissed the request without choosing
+)
+
+// ElicitRequest asks the user for structured input.
+// RequestedSchema is a flat JSON Schema object whose properties are primitive
+// types (string, number, integer, boolean), optionally with an enum.
+type ElicitRequest struct {
+	Message         string                 `json:"message"`
+	RequestedSchema map[string]interface{} `json:"requestedSchema"`
+}
+
+// ElicitResult carries the user's answer; Content is only set when Action is accept
+type ElicitResult struct {
+	Action  string                 `json:"action"`
+	Content map[string]interface{} `json:"content,omitempty"`
+}
+
+// Notification methods sent between client and server
+const (
+	NotificationInitialized         = "notifications/initialized"
+	NotificationCancelled           = "notifications/cancelled"
+	NotificationProgress            = "notifications/progress"
+	NotificationMessage             = "notifications/message"
+	NotificationResourceUpdated     = "notifications/resources/updated"
+	NotificationResourceListChanged = "notifications/resources/list_changed"
+	NotificationToolListChanged     = "notifications/tools/list_changed"
+	NotificationPromptListChanged   = "notifications/prompts/list_changed"
+	NotificationRootsListChanged    = "notifications/roots/list_changed"
+)
+
+// CancelledNotification tells the other side to stop processing a request
+type CancelledNotification struct {
+	RequestID interface{} `json:"requestId"`
+	Reason    string      `json:"reason,omitempty"`
+}
+
+// ResourceUpdatedNotification reports that a subscribed resource changed
+type ResourceUpdatedNotification struct {
+	URI string `json:"uri"`
+}
+
+// LogLevel is a syslog severity used by logging/setLevel and notifications/message
+type LogLevel string
+
+// Log levels in increasing order of severity (RFC 5424)
+const (
+	LogLevelDebug     LogLevel = "debug"
+	LogLevelInfo      LogLevel = "info"
+	LogLevelNotice    LogLevel = "notice"
+	LogLevelWarning   LogLevel = "warning"
+	LogLevelError     LogLevel = "error"
+	LogLevelCritical  LogLevel = "critical"
+	LogLevelAlert     LogLevel = "alert"
+	LogLevelEmergency LogLevel = "emergency"
+)
+
+var logLevelSeverity = map[LogLevel]int{
+	LogLevelDebug:     0,
+	LogLevelInfo:      1,
+	LogLevelNotice:    2,
+	LogLevelWarning:   3,
+	LogLevelError:     4,
+	LogLevelCritical:  5,
+	LogLevelAlert:     6,
+	LogLevelEmergency: 7,
+}
+
+// Severity returns the level's rank from 0 (debug) to 7 (emergency), or -1 if the level is unknown
+func (l LogLevel) Severity() int {
+	if severity, ok := logLevelSeverity[l]; ok {
+		return severity
+	}
+	return -1
+}
+
+// ParseLogLevel validates a log level name
+func ParseLogLevel(name string) (LogLevel, error) {
+	level := LogLevel(strings.ToLower(name))
+	if level.Severity() < 0 {
+		return "", fmt.Errorf("invalid log level %q (expected debug, info, notice, warning, error, critical, alert or emergency)", name)
+	}
+	return level, nil
+}
+
+// SetLevelRequest asks the server to send log messages at or above Level
+type SetLevelRequest struct {
+	Level LogLevel `json:"level"`
+}
+
+// LoggingMessageNotification is a log entry emitted by the server
+type LoggingMessageNotification struct {
+	Level  LogLevel    `json:"level"`
+	Logger string      `json:"logger,omitempty"`
+	Data   interface{} `json:"data"`
+}
+
+// ProgressNotification reports progress on a long-running request
+type ProgressNotification struct {
+	ProgressToken interface{} `json:"progressToken"`
+	Progress      float64     `json:"progress"`
+	Total         float64     `json:"total,omitempty"` // Zero when the total is unknown
+	Message       string      `json:"message,omitempty"`
+}
+
 // Utility functions for creating messages
 func NewRequest(id interface{}, method string, params interface{}) *Message {
 	return &Message{
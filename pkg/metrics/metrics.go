@@ -0,0 +1,417 @@
+// Package metrics is a small registry of counters, gauges and histograms that
+// the client and transports update, exposed in the Prometheus text format
+// (https://prometheus.io/docs/instrumenting/exposition_formats/) so agents
+// built on this library can be scraped without further dependencies.
+//
+// Metrics are registered by name with a fixed list of label names; each
+// combination of label values is a separate series, created on first use:
+//
+//	registry := metrics.NewRegistry()
+//	requests := registry.Counter("app_requests_total", "Requests handled.", "method")
+//	requests.With("tools/call").Inc()
+//	http.Handle("/metrics", registry.Handler())
+//
+// Registering a name again with the same type and labels returns the existing
+// metric, so several clients can share one registry.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContentType is the media type of the text exposition format
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultBuckets are histogram bucket upper bounds in seconds, suited to request latencies
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+var (
+	metricName = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelName  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+type metricType string
+
+const (
+	counterType   metricType = "counter"
+	gaugeType     metricType = "gauge"
+	histogramType metricType = "histogram"
+)
+
+// Registry holds metrics and writes them in the text exposition format.
+// It is safe for concurrent use.
+type Registry struct {
+	mu       sync.Mutex
+	families map[string]*family
+}
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{families: make(map[string]*family)}
+}
+
+// family is a metric name with all of its series
+type family struct {
+	name       string
+	help       string
+	typ        metricType
+	labelNames []string
+	buckets    []float64 // Histograms only
+
+	mu     sync.Mutex
+	series map[string]series // By joined label values
+}
+
+// series is one combination of label values
+type series interface {
+	labelValues() []string
+	write(w *bufio.Writer, f *family)
+}
+
+// register returns the family called name, creating it if needed. It panics
+// if the name or labels are invalid or the name is registered differently.
+func (r *Registry) register(name, help string, typ metricType, buckets []float64, labelNames []string) *family {
+	if !metricName.MatchString(name) {
+		panic(fmt.Sprintf("metrics: invalid metric name %q", name))
+	}
+	for _, label := range labelNames {
+		if !labelName.MatchString(label) || strings.HasPrefix(label, "__") || (typ == histogramType && label == "le") {
+			panic(fmt.Sprintf("metrics: invalid label name %q for %s", label, name))
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.families[name]; ok {
+		if existing.typ != typ || strings.Join(existing.labelNames, ",") != strings.Join(labelNames, ",") {
+			panic(fmt.Sprintf("metrics: %s already registered as a %s with labels %v", name, existing.typ, existing.labelNames))
+		}
+		return existing
+	}
+
+	f := &family{
+		name:       name,
+		help:       help,
+		typ:        typ,
+		labelNames: append([]string(nil), labelNames...),
+		buckets:    buckets,
+		series:     make(map[string]series),
+	}
+	r.families[name] = f
+	return f
+}
+
+// get returns the series for labelValues, creating it with create if needed
+func (f *family) get(labelValues []string, create func(values []string) series) series {
+	if len(labelValues) != len(f.labelNames) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", f.name, len(f.labelNames), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s, ok := f.series[key]
+	if !ok {
+		s = create(append([]string(nil), labelValues...))
+		f.series[key] = s
+	}
+	return s
+}
+
+// CounterVec is a counter with labels
+type CounterVec struct {
+	f *family
+}
+
+// Counter registers a counter, a value that only goes up. Names of counters
+// conventionally end in _total.
+func (r *Registry) Counter(name, help string, labelNames ...string) *CounterVec {
+	return &CounterVec{f: r.register(name, help, counterType, nil, labelNames)}
+}
+
+// With returns the counter for the given label values, in registration order
+func (v *CounterVec) With(labelValues ...string) *Counter {
+	return v.f.get(labelValues, func(values []string) series {
+		return &Counter{values: values}
+	}).(*Counter)
+}
+
+// Counter is a single counter series
+type Counter struct {
+	values []string
+	mu     sync.Mutex
+	value  float64
+}
+
+// Inc adds one to the counter
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Add adds delta, which must not be negative, to the counter
+func (c *Counter) Add(delta float64) {
+	if delta < 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.value += delta
+}
+
+// Value returns the current count
+func (c *Counter) Value() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.value
+}
+
+func (c *Counter) labelValues() []string { return c.values }
+
+func (c *Counter) write(w *bufio.Writer, f *family) {
+	writeSample(w, f.name, f.labelNames, c.values, "", "", c.Value())
+}
+
+// GaugeVec is a gauge with labels
+type GaugeVec struct {
+	f *family
+}
+
+// Gauge registers a gauge, a value that can go up and down
+func (r *Registry) Gauge(name, help string, labelNames ...string) *GaugeVec {
+	return &GaugeVec{f: r.register(name, help, gaugeType, nil, labelNames)}
+}
+
+// With returns the gauge for the given label values, in registration order
+func (v *GaugeVec) With(labelValues ...string) *Gauge {
+	return v.f.get(labelValues, func(values []string) series {
+		return &Gauge{values: values}
+	}).(*Gauge)
+}
+
+// Gauge is a single gauge series
+type Gauge struct {
+	values []string
+	mu     sync.Mutex
+	value  float64
+}
+
+// Set sets the gauge to value
+func (g *Gauge) Set(value float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.value = value
+}
+
+// Add adds delta, which may be negative, to the gauge
+func (g *Gauge) Add(delta float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.value += delta
+}
+
+// Inc adds one to the gauge
+func (g *Gauge) Inc() {
+	g.Add(1)
+}
+
+// Dec subtracts one from the gauge
+func (g *Gauge) Dec() {
+	g.Add(-1)
+}
+
+// Value returns the current value
+func (g *Gauge) Value() float64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.value
+}
+
+func (g *Gauge) labelValues() []string { return g.values }
+
+func (g *Gauge) write(w *bufio.Writer, f *family) {
+	writeSample(w, f.name, f.labelNames, g.values, "", "", g.Value())
+}
+
+// HistogramVec is a histogram with labels
+type HistogramVec struct {
+	f *family
+}
+
+// Histogram registers a histogram, which counts observations into buckets
+// with the given upper bounds; nil buckets means DefaultBuckets
+func (r *Registry) Histogram(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &HistogramVec{f: r.register(name, help, histogramType, buckets, labelNames)}
+}
+
+// With returns the histogram for the given label values, in registration order
+func (v *HistogramVec) With(labelValues ...string) *Histogram {
+	return v.f.get(labelValues, func(values []string) series {
+		return &Histogram{values: values, buckets: v.f.buckets, counts: make([]uint64, len(v.f.buckets))}
+	}).(*Histogram)
+}
+
+// Histogram is a single histogram series
+type Histogram struct {
+	values  []string
+	buckets []float64
+	mu      sync.Mutex
+	counts  []uint64 // Per bucket, not cumulative
+	count   uint64
+	sum     float64
+}
+
+// Observe records one observation
+func (h *Histogram) Observe(value float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if i := sort.SearchFloat64s(h.buckets, value); i < len(h.buckets) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += value
+}
+
+// Count returns the number of observations
+func (h *Histogram) Count() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count
+}
+
+// Sum returns the sum of all observations
+func (h *Histogram) Sum() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.sum
+}
+
+func (h *Histogram) labelValues() []string { return h.values }
+
+func (h *Histogram) write(w *bufio.Writer, f *family) {
+	h.mu.Lock()
+	counts := append([]uint64(nil), h.counts...)
+	count, sum := h.count, h.sum
+	h.mu.Unlock()
+
+	var cumulative uint64
+	for i, bound := range h.buckets {
+		cumulative += counts[i]
+		writeSample(w, f.name+"_bucket", f.labelNames, h.values, "le", formatFloat(bound), float64(cumulative))
+	}
+	writeSample(w, f.name+"_bucket", f.labelNames, h.values, "le", "+Inf", float64(count))
+	writeSample(w, f.name+"_sum", f.labelNames, h.values, "", "", sum)
+	writeSample(w, f.name+"_count", f.labelNames, h.values, "", "", float64(count))
+}
+
+// WriteText writes every metric in the text exposition format, sorted by
+// name and then by label values
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.Lock()
+	families := make([]*family, 0, len(r.families))
+	for _, f := range r.families {
+		families = append(families, f)
+	}
+	r.mu.Unlock()
+	sort.Slice(families, func(i, j int) bool { return families[i].name < families[j].name })
+
+	bw := bufio.NewWriter(w)
+	for _, f := range families {
+		f.mu.Lock()
+		all := make([]series, 0, len(f.series))
+		for _, s := range f.series {
+			all = append(all, s)
+		}
+		f.mu.Unlock()
+		sort.Slice(all, func(i, j int) bool {
+			return strings.Join(all[i].labelValues(), "\xff") < strings.Join(all[j].labelValues(), "\xff")
+		})
+
+		if f.help != "" {
+			fmt.Fprintf(bw, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+		}
+		fmt.Fprintf(bw, "# TYPE %s %s\n", f.name, f.typ)
+		for _, s := range all {
+			s.write(bw, f)
+		}
+	}
+	return bw.Flush()
+}
+
+// Handler returns an HTTP handler that serves the registry's metrics for scraping
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		r.WriteText(w)
+	})
+}
+
+// writeSample writes one sample line, with an extra label (le) if extraName is set
+func writeSample(w *bufio.Writer, name string, labelNames, labelValues []string, extraName, extraValue string, value float64) {
+	w.WriteString(name)
+	if len(labelNames) > 0 || extraName != "" {
+		w.WriteByte('{')
+		for i, label := range labelNames {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(label)
+			w.WriteString(`="`)
+			w.WriteString(escapeLabelValue(labelValues[i]))
+			w.WriteByte('"')
+		}
+		if extraName != "" {
+			if len(labelNames) > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(extraName)
+			w.WriteString(`="`)
+			w.WriteString(extraValue)
+			w.WriteByte('"')
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(value))
+	w.WriteByte('\n')
+}
+
+// formatFloat formats a sample value the way Prometheus parses it
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(help string) string {
+	return helpEscaper.Replace(help)
+}
+
+func escapeLabelValue(value string) string {
+	return labelEscaper.Replace(value)
+}
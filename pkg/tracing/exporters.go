@@ -0,0 +1,98 @@
+package tracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// SpanExporter receives every sampled span when it ends.
+//
+// ExportSpan is called on the goroutine that ended the span, often while a
+// request is being completed, so it must return quickly.
+type SpanExporter interface {
+	ExportSpan(span SpanData)
+}
+
+// SpanExporterFunc adapts an ordinary function to the SpanExporter interface
+type SpanExporterFunc func(span SpanData)
+
+// ExportSpan calls f(span)
+func (f SpanExporterFunc) ExportSpan(span SpanData) {
+	f(span)
+}
+
+// MemoryExporter keeps ended spans in memory, mainly for tests
+type MemoryExporter struct {
+	mu    sync.Mutex
+	spans []SpanData
+}
+
+// NewMemoryExporter returns an empty MemoryExporter
+func NewMemoryExporter() *MemoryExporter {
+	return &MemoryExporter{}
+}
+
+// ExportSpan implements SpanExporter
+func (e *MemoryExporter) ExportSpan(span SpanData) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, span)
+}
+
+// Spans returns the spans exported so far, in the order they ended
+func (e *MemoryExporter) Spans() []SpanData {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]SpanData(nil), e.spans...)
+}
+
+// Reset forgets every exported span
+func (e *MemoryExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = nil
+}
+
+// JSONLExporter writes ended spans to a writer as JSON lines
+type JSONLExporter struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+// NewJSONLFileExporter opens (or creates) path for appending and writes spans to it
+func NewJSONLFileExporter(path string) (*JSONLExporter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trace file: %w", err)
+	}
+	return &JSONLExporter{w: file, closer: file}, nil
+}
+
+// NewJSONLExporter writes spans as JSON lines to w, which the caller owns
+func NewJSONLExporter(w io.Writer) *JSONLExporter {
+	return &JSONLExporter{w: w}
+}
+
+// ExportSpan implements SpanExporter
+func (e *JSONLExporter) ExportSpan(span SpanData) {
+	line, err := json.Marshal(span)
+	if err != nil {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.w.Write(append(line, '\n'))
+}
+
+// Close closes the file opened by NewJSONLFileExporter; it does nothing for NewJSONLExporter
+func (e *JSONLExporter) Close() error {
+	if e.closer == nil {
+		return nil
+	}
+	return e.closer.Close()
+}
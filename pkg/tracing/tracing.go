@@ -0,0 +1,295 @@
+// Package tracing records spans for MCP requests and propagates W3C trace
+// context (https://www.w3.org/TR/trace-context/) to servers.
+//
+// A Tracer starts spans as children of the span context carried by a
+// context.Context, so a client request made while handling an agent's span
+// joins the agent's trace. Ended spans are handed to a SpanExporter; the
+// MemoryExporter and JSONLExporter cover tests and simple file output, and
+// other backends can be bridged by implementing SpanExporter.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Keys under which trace context travels in a request's _meta and in HTTP headers
+const (
+	TraceparentKey = "traceparent"
+	TracestateKey  = "tracestate"
+)
+
+// TraceID identifies a trace
+type TraceID [16]byte
+
+// String returns the ID as 32 lowercase hex digits
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// IsValid reports whether the ID is not all zeros
+func (id TraceID) IsValid() bool {
+	return id != TraceID{}
+}
+
+// SpanID identifies a span within a trace
+type SpanID [8]byte
+
+// String returns the ID as 16 lowercase hex digits
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// IsValid reports whether the ID is not all zeros
+func (id SpanID) IsValid() bool {
+	return id != SpanID{}
+}
+
+// SpanContext is the part of a span that is propagated to other services
+type SpanContext struct {
+	TraceID    TraceID
+	SpanID     SpanID
+	Sampled    bool
+	TraceState string // Vendor-specific tracestate, passed on unchanged
+}
+
+// IsValid reports whether both IDs are set
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// Traceparent formats the span context as a traceparent header value
+func (sc SpanContext) Traceparent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return "00-" + sc.TraceID.String() + "-" + sc.SpanID.String() + "-" + flags
+}
+
+// ParseTraceparent parses a traceparent header value and attaches tracestate,
+// which may be empty
+func ParseTraceparent(traceparent, tracestate string) (SpanContext, error) {
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return SpanContext{}, fmt.Errorf("malformed traceparent %q", traceparent)
+	}
+	// Version 00 has exactly four fields; later versions may append more
+	if parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return SpanContext{}, fmt.Errorf("unsupported traceparent version in %q", traceparent)
+	}
+
+	var sc SpanContext
+	var version, flags [1]byte
+	for _, field := range []struct {
+		hex string
+		dst []byte
+	}{
+		{parts[0], version[:]},
+		{parts[1], sc.TraceID[:]},
+		{parts[2], sc.SpanID[:]},
+		{parts[3], flags[:]},
+	} {
+		if strings.ToLower(field.hex) != field.hex {
+			return SpanContext{}, fmt.Errorf("malformed traceparent %q: hex digits must be lowercase", traceparent)
+		}
+		if _, err := hex.Decode(field.dst, []byte(field.hex)); err != nil {
+			return SpanContext{}, fmt.Errorf("malformed traceparent %q: %w", traceparent, err)
+		}
+	}
+	if !sc.IsValid() {
+		return SpanContext{}, fmt.Errorf("invalid traceparent %q: zero trace or span ID", traceparent)
+	}
+
+	sc.Sampled = flags[0]&0x01 != 0
+	sc.TraceState = tracestate
+	return sc, nil
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpanContext returns a context carrying sc, so spans started from
+// it become its children. Use it to continue a trace received from elsewhere.
+func ContextWithSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, sc)
+}
+
+// SpanContextFromContext returns the span context carried by ctx, if any
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	sc, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	return sc, ok && sc.IsValid()
+}
+
+// Span status values
+const (
+	StatusUnset = "unset"
+	StatusOK    = "ok"
+	StatusError = "error"
+)
+
+// SpanData is an ended span as handed to exporters
+type SpanData struct {
+	Name         string                 `json:"name"`
+	TraceID      string                 `json:"traceId"`
+	SpanID       string                 `json:"spanId"`
+	ParentSpanID string                 `json:"parentSpanId,omitempty"` // Empty for a root span
+	TraceState   string                 `json:"traceState,omitempty"`
+	Start        time.Time              `json:"start"`
+	End          time.Time              `json:"end"`
+	Duration     time.Duration          `json:"durationNs"`
+	Attributes   map[string]interface{} `json:"attributes,omitempty"`
+	Status       string                 `json:"status"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+// Span is an operation in progress. Its methods are safe for concurrent use
+// and do nothing once the span has ended.
+type Span struct {
+	tracer  *Tracer
+	context SpanContext
+	parent  SpanID
+
+	mu         sync.Mutex
+	name       string
+	start      time.Time
+	attributes map[string]interface{}
+	status     string
+	err        string
+	ended      bool
+}
+
+// Context returns the span's context, to be propagated with outgoing requests
+func (s *Span) Context() SpanContext {
+	return s.context
+}
+
+// SetAttribute records a key/value pair on the span
+func (s *Span) SetAttribute(key string, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	if s.attributes == nil {
+		s.attributes = make(map[string]interface{})
+	}
+	s.attributes[key] = value
+}
+
+// SetName renames the span, e.g. once the operation is known in more detail
+func (s *Span) SetName(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.ended {
+		s.name = name
+	}
+}
+
+// SetStatus marks the span as succeeded (StatusOK) or failed (StatusError)
+// with a description of the failure
+func (s *Span) SetStatus(status, description string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.ended {
+		s.status = status
+		s.err = description
+	}
+}
+
+// RecordError marks the span as failed with err; a nil err does nothing
+func (s *Span) RecordError(err error) {
+	if err != nil {
+		s.SetStatus(StatusError, err.Error())
+	}
+}
+
+// End finishes the span and exports it if it is sampled. Only the first call has any effect.
+func (s *Span) End() {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	end := time.Now()
+
+	data := SpanData{
+		Name:       s.name,
+		TraceID:    s.context.TraceID.String(),
+		SpanID:     s.context.SpanID.String(),
+		TraceState: s.context.TraceState,
+		Start:      s.start,
+		End:        end,
+		Duration:   end.Sub(s.start),
+		Attributes: s.attributes,
+		Status:     s.status,
+		Error:      s.err,
+	}
+	if s.parent.IsValid() {
+		data.ParentSpanID = s.parent.String()
+	}
+	s.mu.Unlock()
+
+	if s.context.Sampled && s.tracer.exporter != nil {
+		s.tracer.exporter.ExportSpan(data)
+	}
+}
+
+// Tracer starts spans and hands them to an exporter when they end
+type Tracer struct {
+	exporter SpanExporter
+}
+
+// NewTracer returns a tracer that exports ended spans to exporter
+func NewTracer(exporter SpanExporter) *Tracer {
+	return &Tracer{exporter: exporter}
+}
+
+// Start begins a span named name. If ctx carries a span context the span
+// joins its trace as a child and inherits its sampling decision and
+// tracestate; otherwise it starts a new, sampled trace. The returned context
+// carries the new span's context.
+func (t *Tracer) Start(ctx context.Context, name string) (context.Context, *Span) {
+	span := &Span{
+		tracer: t,
+		name:   name,
+		start:  time.Now(),
+		status: StatusUnset,
+	}
+
+	if parent, ok := SpanContextFromContext(ctx); ok {
+		span.context = SpanContext{
+			TraceID:    parent.TraceID,
+			Sampled:    parent.Sampled,
+			TraceState: parent.TraceState,
+		}
+		span.parent = parent.SpanID
+	} else {
+		span.context.TraceID = newTraceID()
+		span.context.Sampled = true
+	}
+	span.context.SpanID = newSpanID()
+
+	return ContextWithSpanContext(ctx, span.context), span
+}
+
+func newTraceID() TraceID {
+	var id TraceID
+	for !id.IsValid() {
+		rand.Read(id[:])
+	}
+	return id
+}
+
+func newSpanID() SpanID {
+	var id SpanID
+	for !id.IsValid() {
+		rand.Read(id[:])
+	}
+	return id
+}
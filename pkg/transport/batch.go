@@ -0,0 +1,41 @@
+package transport
+
+import (
+	"sync"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// batchQueue holds the rest of a received batch while Receive hands its
+// messages out one at a time
+type batchQueue struct {
+	mu       sync.Mutex
+	messages []*mcp.Message
+}
+
+// next returns the oldest queued message, if any
+func (q *batchQueue) next() (*mcp.Message, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.messages) == 0 {
+		return nil, false
+	}
+	message := q.messages[0]
+	q.messages = q.messages[1:]
+	return message, true
+}
+
+// split queues all but the first of the decoded messages and returns the first
+func (q *batchQueue) split(messages []*mcp.Message) *mcp.Message {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.messages = append(q.messages, messages[1:]...)
+	return messages[0]
+}
+
+// reset drops messages left over from a previous connection
+func (q *batchQueue) reset() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.messages = nil
+}
@@ -7,12 +7,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/metrics"
 )
 
 // SSETransport implements Transport for HTTP/SSE connections
@@ -20,14 +22,20 @@ type SSETransport struct {
 	baseURL       string
 	endpoint      string
 	client        *http.Client
+	streamClient  *http.Client // Without a timeout, for the long-lived SSE stream
+	streamCancel  context.CancelFunc
 	sessionID     string
 	sessionURL    string // The actual message endpoint
 	connected     bool
 	mu            sync.RWMutex
 	timeout       time.Duration
 	initialized   bool
-	lastResponse  *mcp.Message
+	responses     chan *mcp.Message // Responses from POST bodies and the SSE stream
+	streamErr     chan error        // Reports the SSE stream ending
+	stopChan      chan struct{}
 	sseConnection *http.Response // Keep SSE connection alive
+	logger        transportLogger
+	metrics       transportMetrics
 }
 
 // NewSSETransport creates a new SSE transport for SSE-based MCP servers
@@ -38,7 +46,10 @@ func NewSSETransport(baseURL, endpoint string) *SSETransport {
 		client: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		timeout: 30 * time.Second,
+		streamClient: &http.Client{},
+		timeout:      30 * time.Second,
+		responses:    make(chan *mcp.Message, 100),
+		streamErr:    make(chan error, 1),
 	}
 }
 
@@ -51,6 +62,7 @@ func (h *SSETransport) Connect(ctx context.Context) error {
 		return nil
 	}
 
+	h.stopChan = make(chan struct{})
 	h.connected = true
 	return nil
 }
@@ -60,7 +72,15 @@ func (h *SSETransport) Close() error {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	if h.connected {
+		close(h.stopChan)
+	}
+
 	// Close the SSE connection if it exists
+	if h.streamCancel != nil {
+		h.streamCancel()
+		h.streamCancel = nil
+	}
 	if h.sseConnection != nil {
 		h.sseConnection.Body.Close()
 		h.sseConnection = nil
@@ -75,6 +95,60 @@ func (h *SSETransport) Close() error {
 
 // Send sends a message over HTTP
 func (h *SSETransport) Send(message *mcp.Message) error {
+	err := h.send(message)
+	h.metrics.sent(err)
+	h.logger.sent(message, err)
+	return err
+}
+
+// SendBatch sends messages as one JSON-RPC batch in a single POST. The
+// session must already be established by sending initialize.
+func (h *SSETransport) SendBatch(messages []*mcp.Message) error {
+	err := h.sendBatch(messages)
+	for _, message := range messages {
+		h.metrics.sent(err)
+		h.logger.sent(message, err)
+	}
+	return err
+}
+
+// Receive returns the next response from a POST body or the SSE stream,
+// waiting up to the transport timeout for one to arrive
+func (h *SSETransport) Receive() (*mcp.Message, error) {
+	message, err := h.receive()
+	h.metrics.received(err)
+	h.logger.received(message, err)
+	return message, err
+}
+
+// SetLogger logs every message sent and received, with its payload, at
+// debug level; nil stops logging
+func (h *SSETransport) SetLogger(logger *slog.Logger) {
+	h.logger.set(logger, "sse")
+}
+
+// SetMetrics counts the messages and errors of this transport in registry;
+// nil stops counting
+func (h *SSETransport) SetMetrics(registry *metrics.Registry) {
+	h.metrics.register(registry, "sse")
+}
+
+// send implements Send
+func (h *SSETransport) send(message *mcp.Message) error {
+	// Special handling for initialize request, which mutates session state
+	if message.Method == "initialize" {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		if !h.connected {
+			return fmt.Errorf("transport not connected")
+		}
+		if !h.initialized {
+			return h.sendInitializeRequest(message)
+		}
+		return h.sendSessionRequest(message)
+	}
+
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
@@ -82,11 +156,6 @@ func (h *SSETransport) Send(message *mcp.Message) error {
 		return fmt.Errorf("transport not connected")
 	}
 
-	// Special handling for initialize request
-	if message.Method == "initialize" && !h.initialized {
-		return h.sendInitializeRequest(message)
-	}
-
 	// For notifications (no ID), use different handling
 	if message.ID == nil {
 		return h.sendNotification(message)
@@ -96,20 +165,39 @@ func (h *SSETransport) Send(message *mcp.Message) error {
 	return h.sendSessionRequest(message)
 }
 
+// sendBatch implements SendBatch
+func (h *SSETransport) sendBatch(messages []*mcp.Message) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if !h.connected {
+		return fmt.Errorf("transport not connected")
+	}
+	return h.sendSessionRequest(messages)
+}
+
 // sendInitializeRequest handles the initial request that establishes session
 func (h *SSETransport) sendInitializeRequest(message *mcp.Message) error {
 	// First, establish SSE connection to get session endpoint
 	sseURL := h.baseURL + h.endpoint
 
-	req, err := http.NewRequest("GET", sseURL, nil)
+	// The stream stays open for the whole session, so it must not be cut off
+	// by the client timeout; only waiting for the endpoint event is bounded.
+	// Close ends the stream by cancelling its context.
+	ctx, cancel := context.WithCancel(context.Background())
+	handshake := time.AfterFunc(h.timeout, cancel)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", sseURL, nil)
 	if err != nil {
+		cancel()
 		return fmt.Errorf("failed to create SSE request: %w", err)
 	}
 
 	req.Header.Set("Accept", "text/event-stream")
 
-	resp, err := h.client.Do(req)
+	resp, err := h.streamClient.Do(req)
 	if err != nil {
+		cancel()
 		return fmt.Errorf("failed to establish SSE connection: %w", err)
 	}
 	// Don't defer close here - we'll close after getting session URL
@@ -120,20 +208,64 @@ func (h *SSETransport) sendInitializeRequest(message *mcp.Message) error {
 		line := scanner.Text()
 
 		if strings.HasPrefix(line, "data: ") {
+			if !handshake.Stop() {
+				break // Timed out; the stream was cancelled
+			}
 			sessionEndpoint := strings.TrimSpace(line[6:]) // Remove "data: " prefix
 			h.sessionURL = h.baseURL + sessionEndpoint
 			// Keep the SSE connection alive by storing it
 			h.sseConnection = resp
+			h.streamCancel = cancel
+			// Responses for the session arrive on the same stream
+			go h.readEvents(scanner, h.stopChan)
 			// Immediately send the initialize request while session is valid
-			return h.sendMessageToSession(message)
+			if err := h.sendMessageToSession(message); err != nil {
+				return err
+			}
+			h.initialized = true
+			return nil
 		}
 	}
 
+	handshake.Stop()
+	cancel()
 	resp.Body.Close()
 	return fmt.Errorf("failed to get session endpoint from SSE")
-} // sendMessageToSession sends a message to the established session endpoint
-func (h *SSETransport) sendMessageToSession(message *mcp.Message) error {
-	data, err := json.Marshal(message)
+}
+
+// readEvents forwards JSON-RPC messages from the SSE stream to Receive
+func (h *SSETransport) readEvents(scanner *bufio.Scanner, stop chan struct{}) {
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+
+		dataStr := strings.TrimSpace(line[6:]) // Remove "data: " prefix
+		messages, err := mcp.DecodeMessages([]byte(dataStr))
+		if err != nil {
+			continue
+		}
+
+		for _, message := range messages {
+			select {
+			case h.responses <- message:
+			case <-stop:
+				return
+			}
+		}
+	}
+
+	select {
+	case <-stop:
+	case h.streamErr <- fmt.Errorf("SSE stream closed: %v", scanner.Err()):
+	default:
+	}
+}
+
+// sendMessageToSession sends a message or a batch to the established session endpoint
+func (h *SSETransport) sendMessageToSession(frame interface{}) error {
+	data, err := json.Marshal(frame)
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
@@ -145,6 +277,9 @@ func (h *SSETransport) sendMessageToSession(message *mcp.Message) error {
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
+	if message, ok := frame.(*mcp.Message); ok {
+		setTraceHeaders(req.Header, message)
+	}
 
 	resp, err := h.client.Do(req)
 	if err != nil {
@@ -158,22 +293,24 @@ func (h *SSETransport) sendMessageToSession(message *mcp.Message) error {
 		return fmt.Errorf("failed to read response: %w", err)
 	}
 
-	// Handle empty response (might be normal for SSE mode)
+	// Handle empty response (normal for SSE mode, where the response
+	// comes through the SSE stream instead)
 	if len(body) == 0 {
-		// For SSE mode, the response might come through the SSE stream
-		// We'll try to read it in the Receive() method
-		h.lastResponse = nil
-		h.initialized = true
 		return nil
 	}
 
-	var response mcp.Message
-	if err := json.Unmarshal(body, &response); err != nil {
+	responses, err := mcp.DecodeMessages(body)
+	if err != nil {
 		return fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	h.lastResponse = &response
-	h.initialized = true
+	for _, response := range responses {
+		select {
+		case h.responses <- response:
+		case <-h.stopChan:
+			return fmt.Errorf("transport closed")
+		}
+	}
 	return nil
 }
 
@@ -196,6 +333,7 @@ func (h *SSETransport) sendNotification(message *mcp.Message) error {
 
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
+	setTraceHeaders(req.Header, message)
 
 	resp, err := h.client.Do(req)
 	if err != nil {
@@ -212,53 +350,38 @@ func (h *SSETransport) sendNotification(message *mcp.Message) error {
 	return nil
 }
 
-// sendSessionRequest sends requests using session URL after initialization
-func (h *SSETransport) sendSessionRequest(message *mcp.Message) error {
+// sendSessionRequest sends a request or a batch using session URL after initialization
+func (h *SSETransport) sendSessionRequest(frame interface{}) error {
 	// Use the session URL instead of the base endpoint
 	if h.sessionURL == "" {
 		return fmt.Errorf("session not established")
 	}
 
-	return h.sendMessageToSession(message)
+	return h.sendMessageToSession(frame)
 }
 
-// Add fields to store the last response
-// Receive returns the stored response from the last Send() call
-func (h *SSETransport) Receive() (*mcp.Message, error) {
+// receive implements Receive
+func (h *SSETransport) receive() (*mcp.Message, error) {
 	h.mu.RLock()
-	defer h.mu.RUnlock()
+	connected := h.connected
+	stop := h.stopChan
+	timeout := h.timeout
+	h.mu.RUnlock()
 
-	if !h.connected {
+	if !connected {
 		return nil, fmt.Errorf("transport not connected")
 	}
 
-	// Return the stored response from the last Send() call
-	if h.lastResponse != nil {
-		response := h.lastResponse
-		h.lastResponse = nil // Clear after returning
+	select {
+	case response := <-h.responses:
 		return response, nil
+	case err := <-h.streamErr:
+		return nil, err
+	case <-stop:
+		return nil, fmt.Errorf("transport closed")
+	case <-time.After(timeout):
+		return nil, ErrReceiveTimeout
 	}
-
-	// If no stored response and we have an SSE connection, try reading from it
-	if h.sseConnection != nil {
-		scanner := bufio.NewScanner(h.sseConnection.Body)
-
-		// Read multiple lines to find the data
-		for scanner.Scan() {
-			line := scanner.Text()
-
-			if strings.HasPrefix(line, "data: ") {
-				dataStr := strings.TrimSpace(line[6:]) // Remove "data: " prefix
-
-				var response mcp.Message
-				if err := json.Unmarshal([]byte(dataStr), &response); err == nil {
-					return &response, nil
-				}
-			}
-		}
-	}
-
-	return nil, fmt.Errorf("no response available")
 }
 
 // GetReader returns nil for HTTP transport as it's request-response based
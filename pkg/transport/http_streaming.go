@@ -6,24 +6,31 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"sync"
 	"time"
 
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/metrics"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/tracing"
 )
 
 // StreamingHTTPTransport implements Transport for streaming HTTP MCP servers
 type StreamingHTTPTransport struct {
-	baseURL      string
-	endpoint     string
-	client       *http.Client
-	sessionID    string
-	connected    bool
-	mu           sync.RWMutex
-	timeout      time.Duration
-	initialized  bool
-	lastResponse *mcp.Message
+	baseURL     string
+	endpoint    string
+	client      *http.Client
+	sessionID   string
+	version     string // Negotiated protocol version sent as MCP-Protocol-Version
+	connected   bool
+	mu          sync.RWMutex
+	timeout     time.Duration
+	initialized bool
+	responses   chan *mcp.Message // Responses read by Send, handed out by Receive
+	stopChan    chan struct{}
+	logger      transportLogger
+	metrics     transportMetrics
 }
 
 // NewStreamingHTTPTransport creates a new streaming HTTP transport
@@ -34,7 +41,8 @@ func NewStreamingHTTPTransport(baseURL, endpoint string) *StreamingHTTPTransport
 		client: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		timeout: 30 * time.Second,
+		timeout:   30 * time.Second,
+		responses: make(chan *mcp.Message, 100),
 	}
 }
 
@@ -47,6 +55,7 @@ func (h *StreamingHTTPTransport) Connect(ctx context.Context) error {
 		return nil
 	}
 
+	h.stopChan = make(chan struct{})
 	h.connected = true
 	return nil
 }
@@ -56,24 +65,88 @@ func (h *StreamingHTTPTransport) Close() error {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	if h.connected {
+		close(h.stopChan)
+	}
 	h.connected = false
 	h.sessionID = ""
+	h.version = ""
 	h.initialized = false
 	return nil
 }
 
+// SetProtocolVersion sets the MCP-Protocol-Version header sent with every
+// request, as required for protocol version 2025-06-18 and later
+func (h *StreamingHTTPTransport) SetProtocolVersion(version string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.version = version
+}
+
 // Send sends a message over HTTP
+//
+// The lock is not held during the HTTP round trip so that concurrent
+// requests are not serialized behind each other.
 func (h *StreamingHTTPTransport) Send(message *mcp.Message) error {
+	err := h.send(message)
+	h.metrics.sent(err)
+	h.logger.sent(message, err)
+	return err
+}
+
+// SendBatch sends messages as one JSON-RPC batch in a single POST
+func (h *StreamingHTTPTransport) SendBatch(messages []*mcp.Message) error {
+	err := h.post(messages)
+	for _, message := range messages {
+		h.metrics.sent(err)
+		h.logger.sent(message, err)
+	}
+	return err
+}
+
+// Receive returns the next response read by Send, waiting up to the
+// transport timeout for one to arrive
+func (h *StreamingHTTPTransport) Receive() (*mcp.Message, error) {
+	message, err := h.receive()
+	h.metrics.received(err)
+	h.logger.received(message, err)
+	return message, err
+}
+
+// SetLogger logs every message sent and received, with its payload, at
+// debug level; nil stops logging
+func (h *StreamingHTTPTransport) SetLogger(logger *slog.Logger) {
+	h.logger.set(logger, "http")
+}
+
+// SetMetrics counts the messages and errors of this transport in registry;
+// nil stops counting
+func (h *StreamingHTTPTransport) SetMetrics(registry *metrics.Registry) {
+	h.metrics.register(registry, "http")
+}
+
+// send implements Send
+func (h *StreamingHTTPTransport) send(message *mcp.Message) error {
+	return h.post(message)
+}
+
+// post sends a message or a batch and hands the messages in the response
+// body, a single response or a batch of them, to Receive
+func (h *StreamingHTTPTransport) post(frame interface{}) error {
 	h.mu.RLock()
-	defer h.mu.RUnlock()
+	connected := h.connected
+	currentSessionID := h.sessionID
+	version := h.version
+	stop := h.stopChan
+	h.mu.RUnlock()
 
-	if !h.connected {
+	if !connected {
 		return fmt.Errorf("transport not connected")
 	}
 
 	url := h.baseURL + h.endpoint
 
-	data, err := json.Marshal(message)
+	data, err := json.Marshal(frame)
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
@@ -87,8 +160,14 @@ func (h *StreamingHTTPTransport) Send(message *mcp.Message) error {
 	req.Header.Set("Content-Type", "application/json")
 
 	// Add session ID to subsequent requests
-	if h.sessionID != "" {
-		req.Header.Set("Mcp-Session-Id", h.sessionID)
+	if currentSessionID != "" {
+		req.Header.Set("Mcp-Session-Id", currentSessionID)
+	}
+	if version != "" {
+		req.Header.Set("MCP-Protocol-Version", version)
+	}
+	if message, ok := frame.(*mcp.Message); ok {
+		setTraceHeaders(req.Header, message)
 	}
 
 	resp, err := h.client.Do(req)
@@ -100,7 +179,9 @@ func (h *StreamingHTTPTransport) Send(message *mcp.Message) error {
 	// Extract session ID from response headers
 	sessionID := resp.Header.Get("Mcp-Session-Id")
 	if sessionID != "" {
+		h.mu.Lock()
 		h.sessionID = sessionID
+		h.mu.Unlock()
 	}
 
 	// Read response body and store for Receive()
@@ -114,32 +195,41 @@ func (h *StreamingHTTPTransport) Send(message *mcp.Message) error {
 		return nil
 	}
 
-	var response mcp.Message
-	if err := json.Unmarshal(body, &response); err != nil {
+	responses, err := mcp.DecodeMessages(body)
+	if err != nil {
 		return fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	h.lastResponse = &response
+	for _, response := range responses {
+		select {
+		case h.responses <- response:
+		case <-stop:
+			return fmt.Errorf("transport closed")
+		}
+	}
 	return nil
 }
 
-// Receive receives a message from HTTP
-func (h *StreamingHTTPTransport) Receive() (*mcp.Message, error) {
+// receive implements Receive
+func (h *StreamingHTTPTransport) receive() (*mcp.Message, error) {
 	h.mu.RLock()
-	defer h.mu.RUnlock()
+	connected := h.connected
+	stop := h.stopChan
+	timeout := h.timeout
+	h.mu.RUnlock()
 
-	if !h.connected {
+	if !connected {
 		return nil, fmt.Errorf("transport not connected")
 	}
 
-	// Return the stored response from the last Send() call
-	if h.lastResponse != nil {
-		response := h.lastResponse
-		h.lastResponse = nil // Clear after returning
+	select {
+	case response := <-h.responses:
 		return response, nil
+	case <-stop:
+		return nil, fmt.Errorf("transport closed")
+	case <-time.After(timeout):
+		return nil, ErrReceiveTimeout
 	}
-
-	return nil, fmt.Errorf("no response available")
 }
 
 // GetReader returns nil for HTTP transport as it's request-response based
@@ -166,3 +256,23 @@ func (h *StreamingHTTPTransport) SetTimeout(timeout time.Duration) {
 	h.timeout = timeout
 	h.client.Timeout = timeout
 }
+
+// setTraceHeaders copies the W3C trace context a request carries in its
+// _meta (see client.TracingInterceptor) to the traceparent and tracestate
+// headers, for servers and proxies that trace at the HTTP layer
+func setTraceHeaders(header http.Header, message *mcp.Message) {
+	params, ok := message.Params.(map[string]interface{})
+	if !ok {
+		return
+	}
+	meta, ok := params["_meta"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	if traceparent, ok := meta[tracing.TraceparentKey].(string); ok && traceparent != "" {
+		header.Set(tracing.TraceparentKey, traceparent)
+		if tracestate, ok := meta[tracing.TracestateKey].(string); ok && tracestate != "" {
+			header.Set(tracing.TracestateKey, tracestate)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// transportLogger logs a transport's messages at debug level once SetLogger is called
+type transportLogger struct {
+	logger atomic.Pointer[slog.Logger]
+}
+
+// set starts logging to logger, labelling records with the transport type
+func (l *transportLogger) set(logger *slog.Logger, transportType string) {
+	if logger == nil {
+		l.logger.Store(nil)
+		return
+	}
+	l.logger.Store(logger.With("transport", transportType))
+}
+
+// debug returns the logger if it is set and enables debug records, nil otherwise
+func (l *transportLogger) debug() *slog.Logger {
+	logger := l.logger.Load()
+	if logger == nil || !logger.Enabled(context.Background(), slog.LevelDebug) {
+		return nil
+	}
+	return logger
+}
+
+// sent logs the outcome of a Send with the message payload
+func (l *transportLogger) sent(message *mcp.Message, err error) {
+	logger := l.debug()
+	if logger == nil {
+		return
+	}
+	if err != nil {
+		logger.Debug("send failed", append(messageAttrs(message), "error", err)...)
+		return
+	}
+	logger.Debug("sent message", append(messageAttrs(message), "payload", payload(message))...)
+}
+
+// received logs the outcome of a Receive; timeouts while idle are not logged
+func (l *transportLogger) received(message *mcp.Message, err error) {
+	logger := l.debug()
+	if logger == nil || errors.Is(err, ErrReceiveTimeout) {
+		return
+	}
+	if err != nil {
+		logger.Debug("receive failed", "error", err)
+		return
+	}
+	logger.Debug("received message", append(messageAttrs(message), "payload", payload(message))...)
+}
+
+// messageAttrs returns the method and request_id attributes of a message
+func messageAttrs(message *mcp.Message) []any {
+	var attrs []any
+	if message.Method != "" {
+		attrs = append(attrs, "method", message.Method)
+	}
+	if message.ID != nil {
+		attrs = append(attrs, "request_id", message.ID)
+	}
+	return attrs
+}
+
+// payload returns a message's JSON encoding for logging
+func payload(message *mcp.Message) string {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
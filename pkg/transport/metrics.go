@@ -0,0 +1,66 @@
+package transport
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/metrics"
+)
+
+// transportMetrics counts a transport's traffic once SetMetrics is called
+type transportMetrics struct {
+	counters atomic.Pointer[transportCounters]
+}
+
+type transportCounters struct {
+	sent          *metrics.Counter
+	received      *metrics.Counter
+	sendErrors    *metrics.Counter
+	receiveErrors *metrics.Counter
+}
+
+// register starts counting in registry, labelling the series with the transport type
+func (m *transportMetrics) register(registry *metrics.Registry, transportType string) {
+	if registry == nil {
+		m.counters.Store(nil)
+		return
+	}
+
+	messages := registry.Counter("mcp_transport_messages_total",
+		"Messages sent or received by MCP transports.", "transport", "direction")
+	errs := registry.Counter("mcp_transport_errors_total",
+		"Failed sends and receives on MCP transports.", "transport", "direction")
+
+	m.counters.Store(&transportCounters{
+		sent:          messages.With(transportType, "sent"),
+		received:      messages.With(transportType, "received"),
+		sendErrors:    errs.With(transportType, "sent"),
+		receiveErrors: errs.With(transportType, "received"),
+	})
+}
+
+// sent records the outcome of a Send
+func (m *transportMetrics) sent(err error) {
+	counters := m.counters.Load()
+	if counters == nil {
+		return
+	}
+	if err != nil {
+		counters.sendErrors.Inc()
+	} else {
+		counters.sent.Inc()
+	}
+}
+
+// received records the outcome of a Receive; timeouts while idle are not errors
+func (m *transportMetrics) received(err error) {
+	counters := m.counters.Load()
+	if counters == nil || errors.Is(err, ErrReceiveTimeout) {
+		return
+	}
+	if err != nil {
+		counters.receiveErrors.Inc()
+	} else {
+		counters.received.Inc()
+	}
+}
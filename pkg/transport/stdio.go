@@ -6,10 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"os/exec"
 	"sync"
 
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/metrics"
 )
 
 // StdioTransport implements Transport for STDIO-based connections (processes)
@@ -24,6 +26,10 @@ type StdioTransport struct {
 	writer    *bufio.Writer
 	connected bool
 	mu        sync.RWMutex
+	writeMu   sync.Mutex // Serializes concurrent Send calls
+	logger    transportLogger
+	metrics   transportMetrics
+	batched   batchQueue // Rest of a received batch
 }
 
 // NewStdioTransport creates a new STDIO transport
@@ -70,6 +76,7 @@ func (s *StdioTransport) Connect(ctx context.Context) error {
 	s.reader = bufio.NewReader(s.stdout)
 	s.writer = bufio.NewWriter(s.stdin)
 	s.connected = true
+	s.batched.reset()
 
 	return nil
 }
@@ -127,6 +134,44 @@ func (s *StdioTransport) Close() error {
 
 // Send sends a message via STDIO
 func (s *StdioTransport) Send(message *mcp.Message) error {
+	err := s.send(message)
+	s.metrics.sent(err)
+	s.logger.sent(message, err)
+	return err
+}
+
+// SendBatch sends messages as one JSON-RPC batch
+func (s *StdioTransport) SendBatch(messages []*mcp.Message) error {
+	err := s.send(messages)
+	for _, message := range messages {
+		s.metrics.sent(err)
+		s.logger.sent(message, err)
+	}
+	return err
+}
+
+// Receive receives a message from STDIO
+func (s *StdioTransport) Receive() (*mcp.Message, error) {
+	message, err := s.receive()
+	s.metrics.received(err)
+	s.logger.received(message, err)
+	return message, err
+}
+
+// SetLogger logs every message sent and received, with its payload, at
+// debug level; nil stops logging
+func (s *StdioTransport) SetLogger(logger *slog.Logger) {
+	s.logger.set(logger, "stdio")
+}
+
+// SetMetrics counts the messages and errors of this transport in registry;
+// nil stops counting
+func (s *StdioTransport) SetMetrics(registry *metrics.Registry) {
+	s.metrics.register(registry, "stdio")
+}
+
+// send implements Send and SendBatch, writing a message or a batch as one line
+func (s *StdioTransport) send(frame interface{}) error {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -134,11 +179,14 @@ func (s *StdioTransport) Send(message *mcp.Message) error {
 		return fmt.Errorf("transport not connected")
 	}
 
-	data, err := json.Marshal(message)
+	data, err := json.Marshal(frame)
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
 
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
 	// Write message with newline delimiter
 	_, err = s.writer.Write(append(data, '\n'))
 	if err != nil {
@@ -148,26 +196,34 @@ func (s *StdioTransport) Send(message *mcp.Message) error {
 	return s.writer.Flush()
 }
 
-// Receive receives a message from STDIO
-func (s *StdioTransport) Receive() (*mcp.Message, error) {
+// receive implements Receive
+func (s *StdioTransport) receive() (*mcp.Message, error) {
+	if message, ok := s.batched.next(); ok {
+		return message, nil
+	}
+
+	// Don't hold the lock while blocked on stdout, otherwise Close
+	// could never acquire it to unblock us.
 	s.mu.RLock()
-	defer s.mu.RUnlock()
+	connected := s.connected
+	reader := s.reader
+	s.mu.RUnlock()
 
-	if !s.connected {
+	if !connected {
 		return nil, fmt.Errorf("transport not connected")
 	}
 
-	line, err := s.reader.ReadBytes('\n')
+	line, err := reader.ReadBytes('\n')
 	if err != nil {
 		return nil, fmt.Errorf("failed to read message: %w", err)
 	}
 
-	var message mcp.Message
-	if err := json.Unmarshal(line, &message); err != nil {
+	messages, err := mcp.DecodeMessages(line)
+	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal message: %w", err)
 	}
 
-	return &message, nil
+	return s.batched.split(messages), nil
 }
 
 // GetReader returns the stdout reader
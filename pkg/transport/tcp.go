@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/metrics"
 )
 
 // TCPTransport implements Transport for TCP connections
@@ -23,8 +25,11 @@ type TCPTransport struct {
 	writer    *bufio.Writer
 	connected bool
 	mu        sync.RWMutex
+	writeMu   sync.Mutex // Serializes concurrent Send calls
 	timeout   time.Duration
-	debug     bool // Enable debug logging
+	logger    transportLogger
+	metrics   transportMetrics
+	batched   batchQueue // Rest of a received batch
 }
 
 // NewTCPTransport creates a new TCP transport
@@ -60,6 +65,7 @@ func (t *TCPTransport) Connect(ctx context.Context) error {
 	t.reader = bufio.NewReader(conn)
 	t.writer = bufio.NewWriter(conn)
 	t.connected = true
+	t.batched.reset()
 
 	return nil
 }
@@ -84,6 +90,54 @@ func (t *TCPTransport) Close() error {
 
 // Send sends a message over TCP
 func (t *TCPTransport) Send(message *mcp.Message) error {
+	err := t.send(message)
+	t.metrics.sent(err)
+	t.logger.sent(message, err)
+	return err
+}
+
+// SendBatch sends messages as one JSON-RPC batch
+func (t *TCPTransport) SendBatch(messages []*mcp.Message) error {
+	err := t.send(messages)
+	for _, message := range messages {
+		t.metrics.sent(err)
+		t.logger.sent(message, err)
+	}
+	return err
+}
+
+// Receive receives a message from TCP
+func (t *TCPTransport) Receive() (*mcp.Message, error) {
+	message, err := t.receive()
+	t.metrics.received(err)
+	t.logger.received(message, err)
+	return message, err
+}
+
+// SetLogger logs every message sent and received, with its payload, at
+// debug level; nil stops logging
+func (t *TCPTransport) SetLogger(logger *slog.Logger) {
+	t.logger.set(logger, "tcp")
+}
+
+// SetDebug logs every message sent and received to stderr when debug is true.
+//
+// Deprecated: Use SetLogger with a logger at slog.LevelDebug. SetDebug(false)
+// does nothing.
+func (t *TCPTransport) SetDebug(debug bool) {
+	if debug {
+		t.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	}
+}
+
+// SetMetrics counts the messages and errors of this transport in registry;
+// nil stops counting
+func (t *TCPTransport) SetMetrics(registry *metrics.Registry) {
+	t.metrics.register(registry, "tcp")
+}
+
+// send implements Send and SendBatch, writing a message or a batch as one line
+func (t *TCPTransport) send(frame interface{}) error {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
@@ -91,11 +145,14 @@ func (t *TCPTransport) Send(message *mcp.Message) error {
 		return fmt.Errorf("transport not connected")
 	}
 
-	data, err := json.Marshal(message)
+	data, err := json.Marshal(frame)
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
 
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+
 	// Write message with newline delimiter
 	_, err = t.writer.Write(append(data, '\n'))
 	if err != nil {
@@ -110,38 +167,34 @@ func (t *TCPTransport) Send(message *mcp.Message) error {
 	return nil
 }
 
-// Receive receives a message from TCP
-func (t *TCPTransport) Receive() (*mcp.Message, error) {
+// receive implements Receive
+func (t *TCPTransport) receive() (*mcp.Message, error) {
+	if message, ok := t.batched.next(); ok {
+		return message, nil
+	}
+
+	// Don't hold the lock while blocked on the socket, otherwise Close
+	// could never acquire it to unblock us.
 	t.mu.RLock()
-	defer t.mu.RUnlock()
+	connected := t.connected
+	reader := t.reader
+	t.mu.RUnlock()
 
-	if !t.connected {
+	if !connected {
 		return nil, fmt.Errorf("transport not connected")
 	}
 
-	line, err := t.reader.ReadBytes('\n')
+	line, err := reader.ReadBytes('\n')
 	if err != nil {
 		return nil, fmt.Errorf("failed to read message: %w", err)
 	}
 
-	if t.debug {
-		log.Printf("DEBUG TCP Receive: Raw bytes (length=%d): %s", len(line), string(line))
-	}
-
-	var message mcp.Message
-	if err := json.Unmarshal(line, &message); err != nil {
-		if t.debug {
-			log.Printf("ERROR TCP Receive: Unmarshal failed - %v", err)
-		}
+	messages, err := mcp.DecodeMessages(line)
+	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal message (data=%s): %w", string(line), err)
 	}
 
-	if t.debug {
-		log.Printf("DEBUG TCP Receive: Unmarshaled - JSONRPC=%s, ID=%v, Method=%s, Result type=%T",
-			message.JSONRPC, message.ID, message.Method, message.Result)
-	}
-
-	return &message, nil
+	return t.batched.split(messages), nil
 }
 
 // GetReader returns the underlying reader
@@ -177,10 +230,3 @@ func (t *TCPTransport) SetTimeout(timeout time.Duration) {
 	defer t.mu.Unlock()
 	t.timeout = timeout
 }
-
-// SetDebug enables or disables debug logging
-func (t *TCPTransport) SetDebug(debug bool) {
-	t.mu.Lock()
-	defer t.mu.Unlock()
-	t.debug = debug
-}
@@ -2,11 +2,16 @@ package transport
 
 import (
 	"context"
+	"errors"
 	"io"
 
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
 )
 
+// ErrReceiveTimeout is returned by Receive when no message arrived within the
+// transport's timeout. The connection is still usable and Receive may be retried.
+var ErrReceiveTimeout = errors.New("timeout receiving message")
+
 // Transport represents a communication transport for MCP protocol
 type Transport interface {
 	// Connect establishes the connection
@@ -18,7 +23,9 @@ type Transport interface {
 	// Send sends a message to the server
 	Send(message *mcp.Message) error
 
-	// Receive receives a message from the server
+	// Receive receives a message from the server.
+	// It is called from a single goroutine and must return an error once the
+	// transport is closed so that blocked readers are released.
 	Receive() (*mcp.Message, error)
 
 	// GetReader returns the underlying reader
@@ -30,3 +37,11 @@ type Transport interface {
 	// IsConnected returns true if the transport is connected
 	IsConnected() bool
 }
+
+// BatchSender is implemented by transports that can send several messages
+// as one JSON-RPC batch, a JSON array in a single frame. All the built-in
+// transports implement it. Whatever the transport, Receive returns the
+// messages of a batch the server sends one at a time.
+type BatchSender interface {
+	SendBatch(messages []*mcp.Message) error
+}
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/url"
 	"sync"
 	"time"
 
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/metrics"
 
 	"github.com/gorilla/websocket"
 )
@@ -25,17 +27,16 @@ type WebSocketTransport struct {
 	writeChan chan []byte
 	stopChan  chan struct{}
 	errorChan chan error
+	logger    transportLogger
+	metrics   transportMetrics
+	batched   batchQueue // Rest of a received batch
 }
 
 // NewWebSocketTransport creates a new WebSocket transport
 func NewWebSocketTransport(wsURL string) *WebSocketTransport {
 	return &WebSocketTransport{
-		url:       wsURL,
-		timeout:   30 * time.Second,
-		readChan:  make(chan []byte, 100),
-		writeChan: make(chan []byte, 100),
-		stopChan:  make(chan struct{}),
-		errorChan: make(chan error, 10),
+		url:     wsURL,
+		timeout: 30 * time.Second,
 	}
 }
 
@@ -67,10 +68,18 @@ func (w *WebSocketTransport) Connect(ctx context.Context) error {
 
 	w.conn = conn
 	w.connected = true
+	w.batched.reset()
+
+	// Each connection gets its own channels so a reconnect isn't affected by
+	// the closed stop channel or stale errors and messages of the previous one
+	w.stopChan = make(chan struct{})
+	w.errorChan = make(chan error, 10)
+	w.readChan = make(chan []byte, 100)
+	w.writeChan = make(chan []byte, 100)
 
 	// Start goroutines for reading and writing
-	go w.readLoop()
-	go w.writeLoop()
+	go w.readLoop(conn, w.readChan, w.errorChan, w.stopChan)
+	go w.writeLoop(conn, w.writeChan, w.errorChan, w.stopChan)
 
 	return nil
 }
@@ -88,7 +97,8 @@ func (w *WebSocketTransport) Close() error {
 	close(w.stopChan)
 
 	// Close WebSocket connection
-	w.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	// WriteControl, unlike WriteMessage, may run concurrently with writeLoop
+	w.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
 	err := w.conn.Close()
 
 	w.connected = false
@@ -99,6 +109,44 @@ func (w *WebSocketTransport) Close() error {
 
 // Send sends a message over WebSocket
 func (w *WebSocketTransport) Send(message *mcp.Message) error {
+	err := w.send(message)
+	w.metrics.sent(err)
+	w.logger.sent(message, err)
+	return err
+}
+
+// SendBatch sends messages as one JSON-RPC batch in a single frame
+func (w *WebSocketTransport) SendBatch(messages []*mcp.Message) error {
+	err := w.send(messages)
+	for _, message := range messages {
+		w.metrics.sent(err)
+		w.logger.sent(message, err)
+	}
+	return err
+}
+
+// Receive receives a message from WebSocket
+func (w *WebSocketTransport) Receive() (*mcp.Message, error) {
+	message, err := w.receive()
+	w.metrics.received(err)
+	w.logger.received(message, err)
+	return message, err
+}
+
+// SetLogger logs every message sent and received, with its payload, at
+// debug level; nil stops logging
+func (w *WebSocketTransport) SetLogger(logger *slog.Logger) {
+	w.logger.set(logger, "websocket")
+}
+
+// SetMetrics counts the messages and errors of this transport in registry;
+// nil stops counting
+func (w *WebSocketTransport) SetMetrics(registry *metrics.Registry) {
+	w.metrics.register(registry, "websocket")
+}
+
+// send implements Send and SendBatch, writing a message or a batch as one frame
+func (w *WebSocketTransport) send(frame interface{}) error {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
@@ -106,7 +154,7 @@ func (w *WebSocketTransport) Send(message *mcp.Message) error {
 		return fmt.Errorf("transport not connected")
 	}
 
-	data, err := json.Marshal(message)
+	data, err := json.Marshal(frame)
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
@@ -119,26 +167,37 @@ func (w *WebSocketTransport) Send(message *mcp.Message) error {
 	}
 }
 
-// Receive receives a message from WebSocket
-func (w *WebSocketTransport) Receive() (*mcp.Message, error) {
+// receive implements Receive
+func (w *WebSocketTransport) receive() (*mcp.Message, error) {
+	if message, ok := w.batched.next(); ok {
+		return message, nil
+	}
+
+	// Don't hold the lock while waiting, otherwise Close could never
+	// acquire it to unblock us.
 	w.mu.RLock()
-	defer w.mu.RUnlock()
+	connected := w.connected
+	timeout := w.timeout
+	readChan, errorChan, stopChan := w.readChan, w.errorChan, w.stopChan
+	w.mu.RUnlock()
 
-	if !w.connected {
+	if !connected {
 		return nil, fmt.Errorf("transport not connected")
 	}
 
 	select {
-	case data := <-w.readChan:
-		var message mcp.Message
-		if err := json.Unmarshal(data, &message); err != nil {
+	case data := <-readChan:
+		messages, err := mcp.DecodeMessages(data)
+		if err != nil {
 			return nil, fmt.Errorf("failed to unmarshal message: %w", err)
 		}
-		return &message, nil
-	case err := <-w.errorChan:
+		return w.batched.split(messages), nil
+	case err := <-errorChan:
 		return nil, err
-	case <-time.After(w.timeout):
-		return nil, fmt.Errorf("timeout receiving message")
+	case <-stopChan:
+		return nil, fmt.Errorf("transport closed")
+	case <-time.After(timeout):
+		return nil, ErrReceiveTimeout
 	}
 }
 
@@ -166,50 +225,62 @@ func (w *WebSocketTransport) SetTimeout(timeout time.Duration) {
 	w.timeout = timeout
 }
 
-// readLoop handles reading messages from WebSocket
-func (w *WebSocketTransport) readLoop() {
+// readLoop handles reading messages from one WebSocket connection
+func (w *WebSocketTransport) readLoop(conn *websocket.Conn, readChan chan<- []byte, errorChan chan<- error, stopChan <-chan struct{}) {
 	defer func() {
 		if r := recover(); r != nil {
-			w.errorChan <- fmt.Errorf("read loop panic: %v", r)
+			reportError(errorChan, fmt.Errorf("read loop panic: %v", r))
 		}
 	}()
 
 	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			select {
+			case <-stopChan:
+				// Closed by Close; Receive already reports that
+			default:
+				reportError(errorChan, fmt.Errorf("failed to read WebSocket message: %w", err))
+			}
+			return
+		}
 		select {
-		case <-w.stopChan:
+		case readChan <- message:
+		case <-stopChan:
 			return
-		default:
-			_, message, err := w.conn.ReadMessage()
-			if err != nil {
-				w.errorChan <- fmt.Errorf("failed to read WebSocket message: %w", err)
-				return
-			}
-			w.readChan <- message
 		}
 	}
 }
 
-// writeLoop handles writing messages to WebSocket
-func (w *WebSocketTransport) writeLoop() {
+// writeLoop handles writing messages to one WebSocket connection
+func (w *WebSocketTransport) writeLoop(conn *websocket.Conn, writeChan <-chan []byte, errorChan chan<- error, stopChan <-chan struct{}) {
 	defer func() {
 		if r := recover(); r != nil {
-			w.errorChan <- fmt.Errorf("write loop panic: %v", r)
+			reportError(errorChan, fmt.Errorf("write loop panic: %v", r))
 		}
 	}()
 
 	for {
 		select {
-		case <-w.stopChan:
+		case <-stopChan:
 			return
-		case data := <-w.writeChan:
-			if err := w.conn.WriteMessage(websocket.TextMessage, data); err != nil {
-				w.errorChan <- fmt.Errorf("failed to write WebSocket message: %w", err)
+		case data := <-writeChan:
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				reportError(errorChan, fmt.Errorf("failed to write WebSocket message: %w", err))
 				return
 			}
 		}
 	}
 }
 
+// reportError queues err for Receive without blocking a loop that is exiting
+func reportError(errorChan chan<- error, err error) {
+	select {
+	case errorChan <- err:
+	default:
+	}
+}
+
 // GetURL returns the WebSocket URL
 func (w *WebSocketTransport) GetURL() string {
 	return w.url
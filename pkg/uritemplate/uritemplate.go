@@ -0,0 +1,385 @@
+// Package uritemplate implements RFC 6570 URI templates (up to level 4),
+// as used by MCP resource templates such as "file:///{+path}".
+package uritemplate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Template is a parsed URI template
+type Template struct {
+	raw   string
+	parts []part
+}
+
+// part is either a literal string or an expression
+type part struct {
+	literal string
+	expr    *expression
+}
+
+// expression is a single {...} block
+type expression struct {
+	op   operator
+	vars []varSpec
+}
+
+// varSpec is a variable reference with its optional modifier
+type varSpec struct {
+	name    string
+	prefix  int // Max characters for ":N"; zero when absent
+	explode bool
+}
+
+// operator describes how an expression's values are joined (RFC 6570 Appendix A)
+type operator struct {
+	first         string
+	sep           string
+	named         bool
+	ifEmpty       string
+	allowReserved bool
+}
+
+var operators = map[byte]operator{
+	'+': {first: "", sep: ",", allowReserved: true},
+	'#': {first: "#", sep: ",", allowReserved: true},
+	'.': {first: ".", sep: "."},
+	'/': {first: "/", sep: "/"},
+	';': {first: ";", sep: ";", named: true},
+	'?': {first: "?", sep: "&", named: true, ifEmpty: "="},
+	'&': {first: "&", sep: "&", named: true, ifEmpty: "="},
+}
+
+// Parse parses a URI template
+func Parse(template string) (*Template, error) {
+	t := &Template{raw: template}
+
+	rest := template
+	for rest != "" {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			if strings.IndexByte(rest, '}') >= 0 {
+				return nil, fmt.Errorf("uritemplate: unmatched '}' in %q", template)
+			}
+			t.parts = append(t.parts, part{literal: rest})
+			break
+		}
+		if open > 0 {
+			if strings.IndexByte(rest[:open], '}') >= 0 {
+				return nil, fmt.Errorf("uritemplate: unmatched '}' in %q", template)
+			}
+			t.parts = append(t.parts, part{literal: rest[:open]})
+		}
+
+		end := strings.IndexByte(rest[open:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("uritemplate: unterminated expression in %q", template)
+		}
+		expr, err := parseExpression(rest[open+1 : open+end])
+		if err != nil {
+			return nil, fmt.Errorf("uritemplate: %w in %q", err, template)
+		}
+		t.parts = append(t.parts, part{expr: expr})
+		rest = rest[open+end+1:]
+	}
+
+	return t, nil
+}
+
+// MustParse is like Parse but panics on error
+func MustParse(template string) *Template {
+	t, err := Parse(template)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// parseExpression parses the contents of a {...} block
+func parseExpression(body string) (*expression, error) {
+	if body == "" {
+		return nil, fmt.Errorf("empty expression")
+	}
+
+	expr := &expression{op: operator{sep: ","}}
+	if op, ok := operators[body[0]]; ok {
+		expr.op = op
+		body = body[1:]
+	} else if strings.IndexByte("=,!@|", body[0]) >= 0 {
+		return nil, fmt.Errorf("reserved operator %q", body[0])
+	}
+
+	for _, spec := range strings.Split(body, ",") {
+		v := varSpec{name: spec}
+		if strings.HasSuffix(spec, "*") {
+			v.name = strings.TrimSuffix(spec, "*")
+			v.explode = true
+		} else if i := strings.IndexByte(spec, ':'); i >= 0 {
+			v.name = spec[:i]
+			var n int
+			if _, err := fmt.Sscanf(spec[i+1:], "%d", &n); err != nil || n <= 0 || n >= 10000 || fmt.Sprint(n) != spec[i+1:] {
+				return nil, fmt.Errorf("invalid prefix modifier %q", spec)
+			}
+			v.prefix = n
+		}
+		if !validName(v.name) {
+			return nil, fmt.Errorf("invalid variable name %q", v.name)
+		}
+		expr.vars = append(expr.vars, v)
+	}
+
+	return expr, nil
+}
+
+// validName reports whether name is a valid RFC 6570 varname
+func validName(name string) bool {
+	if name == "" || name[0] == '.' || name[len(name)-1] == '.' {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case isAlphaNum(c) || c == '_' || c == '.':
+		case c == '%' && i+2 < len(name) && isHex(name[i+1]) && isHex(name[i+2]):
+			i += 2
+		default:
+			return false
+		}
+	}
+	return !strings.Contains(name, "..")
+}
+
+// String returns the original template text
+func (t *Template) String() string {
+	return t.raw
+}
+
+// Variables returns the names of the template's variables in order of first use
+func (t *Template) Variables() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, p := range t.parts {
+		if p.expr == nil {
+			continue
+		}
+		for _, v := range p.expr.vars {
+			if !seen[v.name] {
+				seen[v.name] = true
+				names = append(names, v.name)
+			}
+		}
+	}
+	return names
+}
+
+// Expand substitutes vars into the template.
+//
+// Values may be strings (or any value formatted with fmt.Sprint), lists
+// ([]string or []interface{}) or associative arrays (map[string]string or
+// map[string]interface{}; keys are expanded in sorted order). Missing and
+// nil variables are undefined and omitted, as the RFC specifies.
+func (t *Template) Expand(vars map[string]interface{}) (string, error) {
+	var b strings.Builder
+	for _, p := range t.parts {
+		if p.expr == nil {
+			b.WriteString(p.literal)
+			continue
+		}
+		if err := p.expr.expand(&b, vars); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+// expand writes the expansion of one expression
+func (e *expression) expand(b *strings.Builder, vars map[string]interface{}) error {
+	first := true
+	for _, v := range e.vars {
+		value, ok := vars[v.name]
+		if !ok || value == nil {
+			continue
+		}
+
+		var s strings.Builder
+		defined, err := e.expandVar(&s, v, value)
+		if err != nil {
+			return err
+		}
+		if !defined {
+			continue
+		}
+
+		if first {
+			b.WriteString(e.op.first)
+			first = false
+		} else {
+			b.WriteString(e.op.sep)
+		}
+		b.WriteString(s.String())
+	}
+	return nil
+}
+
+// expandVar writes a single variable and reports whether it was defined
+func (e *expression) expandVar(b *strings.Builder, v varSpec, value interface{}) (bool, error) {
+	op := e.op
+
+	switch val := value.(type) {
+	case []string:
+		return e.expandList(b, v, val)
+	case []interface{}:
+		items := make([]string, len(val))
+		for i, item := range val {
+			items[i] = fmt.Sprint(item)
+		}
+		return e.expandList(b, v, items)
+	case map[string]string:
+		return e.expandMap(b, v, val)
+	case map[string]interface{}:
+		pairs := make(map[string]string, len(val))
+		for k, item := range val {
+			pairs[k] = fmt.Sprint(item)
+		}
+		return e.expandMap(b, v, pairs)
+	}
+
+	s := fmt.Sprint(value)
+	if v.prefix > 0 && utf8.RuneCountInString(s) > v.prefix {
+		s = string([]rune(s)[:v.prefix])
+	}
+	if op.named {
+		b.WriteString(v.name)
+		if s == "" {
+			b.WriteString(op.ifEmpty)
+			return true, nil
+		}
+		b.WriteByte('=')
+	}
+	b.WriteString(encode(s, op.allowReserved))
+	return true, nil
+}
+
+// expandList writes a list value
+func (e *expression) expandList(b *strings.Builder, v varSpec, items []string) (bool, error) {
+	if v.prefix > 0 {
+		return false, fmt.Errorf("uritemplate: prefix modifier not allowed on list variable %q", v.name)
+	}
+	if len(items) == 0 {
+		return false, nil
+	}
+
+	op := e.op
+	if !v.explode {
+		if op.named {
+			b.WriteString(v.name)
+			b.WriteByte('=')
+		}
+		for i, item := range items {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(encode(item, op.allowReserved))
+		}
+		return true, nil
+	}
+
+	for i, item := range items {
+		if i > 0 {
+			b.WriteString(op.sep)
+		}
+		if op.named {
+			b.WriteString(v.name)
+			if item == "" {
+				b.WriteString(op.ifEmpty)
+				continue
+			}
+			b.WriteByte('=')
+		}
+		b.WriteString(encode(item, op.allowReserved))
+	}
+	return true, nil
+}
+
+// expandMap writes an associative array value
+func (e *expression) expandMap(b *strings.Builder, v varSpec, pairs map[string]string) (bool, error) {
+	if v.prefix > 0 {
+		return false, fmt.Errorf("uritemplate: prefix modifier not allowed on map variable %q", v.name)
+	}
+	if len(pairs) == 0 {
+		return false, nil
+	}
+
+	keys := make([]string, 0, len(pairs))
+	for k := range pairs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	op := e.op
+	if !v.explode {
+		if op.named {
+			b.WriteString(v.name)
+			b.WriteByte('=')
+		}
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(encode(k, op.allowReserved))
+			b.WriteByte(',')
+			b.WriteString(encode(pairs[k], op.allowReserved))
+		}
+		return true, nil
+	}
+
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteString(op.sep)
+		}
+		b.WriteString(encode(k, op.allowReserved))
+		if op.named && pairs[k] == "" {
+			b.WriteString(op.ifEmpty)
+			continue
+		}
+		b.WriteByte('=')
+		b.WriteString(encode(pairs[k], op.allowReserved))
+	}
+	return true, nil
+}
+
+// encode percent-encodes s, leaving unreserved characters (and reserved
+// characters and existing pct-encoded triplets when allowReserved) intact
+func encode(s string, allowReserved bool) string {
+	const hex = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case isAlphaNum(c) || strings.IndexByte("-._~", c) >= 0:
+			b.WriteByte(c)
+		case allowReserved && strings.IndexByte(":/?#[]@!$&'()*+,;=", c) >= 0:
+			b.WriteByte(c)
+		case allowReserved && c == '%' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]):
+			b.WriteString(s[i : i+3])
+			i += 2
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&0x0F])
+		}
+	}
+	return b.String()
+}
+
+func isAlphaNum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
@@ -0,0 +1,407 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/jsonschema"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+)
+
+// batchingTransport adds SendBatch to the mock transport, whose server
+// answers the requests of a batch one by one
+type batchingTransport struct {
+	*mockTransport
+	mu      sync.Mutex
+	batches [][]*mcp.Message
+}
+
+func (b *batchingTransport) SendBatch(messages []*mcp.Message) error {
+	b.mu.Lock()
+	b.batches = append(b.batches, messages)
+	b.mu.Unlock()
+	for _, message := range messages {
+		if err := b.mockTransport.Send(message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *batchingTransport) sentBatches() [][]*mcp.Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([][]*mcp.Message(nil), b.batches...)
+}
+
+// newBatchServer answers tools/call with the tool name, resources/read with
+// the URI and fails prompts/get
+func newBatchServer(version string) *batchingTransport {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		params, _ := msg.Params.(map[string]interface{})
+		switch msg.Method {
+		case "tools/call":
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{
+				"content": []interface{}{map[string]interface{}{"type": "text", "text": params["name"]}},
+			}))
+		case "resources/read":
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{
+				"contents": []interface{}{map[string]interface{}{"uri": params["uri"], "text": "hello"}},
+			}))
+		case "prompts/get":
+			m.push(mcp.NewErrorResponse(msg.ID, mcp.ErrorCodeInvalidParams, "unknown prompt", nil))
+		}
+	})
+	m.protocolVersion = version
+	return &batchingTransport{mockTransport: m}
+}
+
+func checkBatchResults(t *testing.T, results []client.BatchResult) {
+	t.Helper()
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+
+	var tool mcp.CallToolResponse
+	if err := results[0].Decode(&tool); err != nil || toolText(&tool) != "search" {
+		t.Errorf("Unexpected tools/call result %+v: %v", tool, err)
+	}
+	var resource mcp.ReadResourceResponse
+	if err := results[1].Decode(&resource); err != nil || len(resource.Contents) != 1 || resource.Contents[0].URI != "file:///a.txt" {
+		t.Errorf("Unexpected resources/read result %+v: %v", resource, err)
+	}
+	var mcpErr *client.MCPError
+	if !errors.As(results[2].Err, &mcpErr) || mcpErr.Code != mcp.ErrorCodeInvalidParams {
+		t.Errorf("Expected prompts/get to fail with invalid params, got %v", results[2].Err)
+	}
+	if results[2].Method != "prompts/get" || results[2].Response == nil {
+		t.Errorf("Expected the error response to be kept, got %+v", results[2])
+	}
+}
+
+func TestBatchSendsOneFrame(t *testing.T) {
+	b := newBatchServer(mcp.ProtocolVersion20250326)
+	c := newInitializedClient(t, b, client.ClientConfig{ProtocolVersion: mcp.ProtocolVersion20250326})
+
+	results, err := c.Batch().
+		CallTool("search", nil).
+		ReadResource("file:///a.txt").
+		GetPrompt("missing", nil).
+		Send(context.Background())
+	if err != nil {
+		t.Fatalf("Batch failed: %v", err)
+	}
+	checkBatchResults(t, results)
+
+	batches := b.sentBatches()
+	if len(batches) != 1 || len(batches[0]) != 3 {
+		t.Fatalf("Expected one batch of 3 requests, got %v", batches)
+	}
+	ids := make(map[interface{}]bool)
+	for _, msg := range batches[0] {
+		ids[msg.ID] = true
+	}
+	if len(ids) != 3 {
+		t.Errorf("Expected distinct request IDs, got %v", ids)
+	}
+}
+
+func TestBatchFallsBackToSequentialSends(t *testing.T) {
+	for name, tr := range map[string]transport.Transport{
+		"protocol version": newBatchServer(mcp.ProtocolVersion20250618),
+		"transport":        newBatchServer(mcp.ProtocolVersion20250326).mockTransport,
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := newInitializedClient(t, tr, client.ClientConfig{})
+			results, err := c.Batch().
+				CallTool("search", nil).
+				ReadResource("file:///a.txt").
+				GetPrompt("missing", nil).
+				Send(context.Background())
+			if err != nil {
+				t.Fatalf("Batch failed: %v", err)
+			}
+			checkBatchResults(t, results)
+
+			if b, ok := tr.(*batchingTransport); ok && len(b.sentBatches()) != 0 {
+				t.Errorf("Expected no batches with protocol %s, got %v", c.ProtocolVersion(), b.sentBatches())
+			}
+		})
+	}
+}
+
+func TestBatchRunsInterceptorsPerRequest(t *testing.T) {
+	var mu sync.Mutex
+	seen := make(map[string]int64)
+	interceptor := func(ctx context.Context, req *client.Request, next client.RequestInvoker) (*mcp.Message, error) {
+		if req.Method == "resources/read" {
+			return mcp.NewResponse(nil, map[string]interface{}{"contents": []interface{}{}}), nil // Cached
+		}
+		response, err := next(ctx, req)
+		mu.Lock()
+		seen[req.Method] = req.ID
+		mu.Unlock()
+		return response, err
+	}
+
+	b := newBatchServer(mcp.ProtocolVersion20250326)
+	c := newInitializedClient(t, b, client.ClientConfig{
+		ProtocolVersion:     mcp.ProtocolVersion20250326,
+		RequestInterceptors: []client.RequestInterceptor{interceptor},
+	})
+
+	results, err := c.Batch().
+		CallTool("search", nil).
+		ReadResource("file:///a.txt").
+		GetPrompt("missing", nil).
+		Send(context.Background())
+	if err != nil {
+		t.Fatalf("Batch failed: %v", err)
+	}
+	if results[1].Err != nil {
+		t.Errorf("Expected the short-circuited request to succeed, got %v", results[1].Err)
+	}
+
+	batches := b.sentBatches()
+	if len(batches) != 1 || len(batches[0]) != 2 {
+		t.Fatalf("Expected one batch without the short-circuited request, got %v", batches)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if seen["tools/call"] == 0 || seen["prompts/get"] == 0 {
+		t.Errorf("Expected interceptors to see the assigned request IDs, got %v", seen)
+	}
+}
+
+func TestBatchRequestHonorsContext(t *testing.T) {
+	release := make(chan struct{})
+	returned := make(chan error, 1)
+	interceptor := func(ctx context.Context, req *client.Request, next client.RequestInvoker) (*mcp.Message, error) {
+		switch req.Method {
+		case "tools/call":
+			ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+			response, err := next(ctx, req)
+			returned <- err
+			return response, err
+		case "prompts/get":
+			<-release // A slow sibling holds the frame back
+		}
+		return next(ctx, req)
+	}
+
+	b := newBatchServer(mcp.ProtocolVersion20250326)
+	c := newInitializedClient(t, b, client.ClientConfig{
+		ProtocolVersion:     mcp.ProtocolVersion20250326,
+		RequestInterceptors: []client.RequestInterceptor{interceptor},
+	})
+
+	done := make(chan []client.BatchResult)
+	go func() {
+		results, _ := c.Batch().CallTool("search", nil).GetPrompt("missing", nil).Send(context.Background())
+		done <- results
+	}()
+
+	select {
+	case err := <-returned:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Error("Request kept waiting for its sibling past its deadline")
+	}
+	close(release)
+	<-done
+
+	batches := b.sentBatches()
+	for _, batch := range batches {
+		for _, msg := range batch {
+			if msg.Method == "tools/call" {
+				t.Errorf("Expected the expired request to be kept out of the batch, got %v", batches)
+			}
+		}
+	}
+}
+
+func TestBatchValidatesToolArguments(t *testing.T) {
+	m := newSearchTransport()
+	m.protocolVersion = mcp.ProtocolVersion20250326
+	b := &batchingTransport{mockTransport: m}
+	c := newInitializedClient(t, b, client.ClientConfig{ProtocolVersion: mcp.ProtocolVersion20250326})
+	ctx := context.Background()
+	if _, err := c.ListTools(ctx); err != nil {
+		t.Fatalf("ListTools failed: %v", err)
+	}
+
+	results, err := c.Batch().
+		CallTool("search", map[string]interface{}{"limit": 100}).
+		CallTool("search", map[string]interface{}{"query": "mcp"}).
+		Send(ctx)
+	if err != nil {
+		t.Fatalf("Batch failed: %v", err)
+	}
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(results[0].Err, &validationErr) {
+		t.Errorf("Expected *jsonschema.ValidationError, got %v", results[0].Err)
+	}
+	if results[1].Err != nil {
+		t.Errorf("Expected the valid call to succeed, got %v", results[1].Err)
+	}
+	if batches := b.sentBatches(); len(batches) != 1 || len(batches[0]) != 1 {
+		t.Errorf("Expected only the valid call to be sent, got %v", batches)
+	}
+
+	results, err = c.Batch().Send(ctx)
+	if err != nil || len(results) != 0 {
+		t.Errorf("Expected an empty batch to send nothing, got %v, %v", results, err)
+	}
+}
+
+func TestBatchRequiresInitialize(t *testing.T) {
+	c := client.NewClient(newBatchServer(mcp.ProtocolVersion20250326), client.ClientConfig{})
+	if _, err := c.Batch().CallTool("search", nil).Send(context.Background()); !errors.Is(err, client.ErrNotInitialized) {
+		t.Errorf("Expected ErrNotInitialized, got %v", err)
+	}
+}
+
+func TestDecodeMessages(t *testing.T) {
+	messages, err := mcp.DecodeMessages([]byte(` [{"jsonrpc":"2.0","id":1,"result":{}},{"jsonrpc":"2.0","method":"notifications/tools/list_changed"}]` + "\n"))
+	if err != nil || len(messages) != 2 || messages[1].Method != "notifications/tools/list_changed" {
+		t.Errorf("Unexpected batch decoding %v: %v", messages, err)
+	}
+	messages, err = mcp.DecodeMessages([]byte(`{"jsonrpc":"2.0","id":2,"result":{}}`))
+	if err != nil || len(messages) != 1 {
+		t.Errorf("Unexpected single message decoding %v: %v", messages, err)
+	}
+	for _, invalid := range []string{`[]`, `[null]`, `[1]`, `{`} {
+		if _, err := mcp.DecodeMessages([]byte(invalid)); err == nil {
+			t.Errorf("Expected %s to be rejected", invalid)
+		}
+	}
+}
+
+// answerBatch answers every request of a frame, replying in kind: a batch
+// with a batch and a single request with a single response
+func answerBatch(data []byte) ([]byte, error) {
+	messages, err := mcp.DecodeMessages(data)
+	if err != nil {
+		return nil, err
+	}
+	var responses []*mcp.Message
+	for _, msg := range messages {
+		switch msg.Method {
+		case "initialize":
+			responses = append(responses, mcp.NewResponse(msg.ID, map[string]interface{}{
+				"protocolVersion": mcp.ProtocolVersion20250326,
+				"capabilities":    map[string]interface{}{},
+				"serverInfo":      map[string]interface{}{"name": "test-server", "version": "1.0.0"},
+			}))
+		case "tools/call":
+			params, _ := msg.Params.(map[string]interface{})
+			responses = append(responses, mcp.NewResponse(msg.ID, map[string]interface{}{
+				"content": []interface{}{map[string]interface{}{"type": "text", "text": params["name"]}},
+			}))
+		}
+	}
+	if len(responses) == 0 {
+		return nil, nil
+	}
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+		return json.Marshal(responses)
+	}
+	return json.Marshal(responses[0])
+}
+
+func TestBatchOverTransports(t *testing.T) {
+	var mu sync.Mutex
+	var frames []string
+	record := func(data []byte) {
+		mu.Lock()
+		frames = append(frames, string(data))
+		mu.Unlock()
+	}
+
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		record(body)
+		response, err := answerBatch(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if response == nil {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.Write(response)
+	}))
+	defer httpServer.Close()
+
+	upgrader := websocket.Upgrader{}
+	wsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			messageType, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			record(data)
+			if response, err := answerBatch(data); err == nil && response != nil {
+				conn.WriteMessage(messageType, response)
+			}
+		}
+	}))
+	defer wsServer.Close()
+
+	for name, tr := range map[string]transport.Transport{
+		"http":      transport.NewStreamingHTTPTransport(httpServer.URL, "/mcp"),
+		"websocket": transport.NewWebSocketTransport("ws" + strings.TrimPrefix(wsServer.URL, "http")),
+	} {
+		t.Run(name, func(t *testing.T) {
+			mu.Lock()
+			frames = nil
+			mu.Unlock()
+
+			c := newInitializedClient(t, tr, client.ClientConfig{ProtocolVersion: mcp.ProtocolVersion20250326})
+			results, err := c.Batch().
+				CallTool("first", nil).
+				CallTool("second", nil).
+				Send(context.Background())
+			if err != nil {
+				t.Fatalf("Batch failed: %v", err)
+			}
+			for i, want := range []string{"first", "second"} {
+				var result mcp.CallToolResponse
+				if err := results[i].Decode(&result); err != nil || toolText(&result) != want {
+					t.Errorf("Result %d: expected %s, got %+v: %v", i, want, result, err)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			last := frames[len(frames)-1]
+			if !strings.HasPrefix(last, "[") || !strings.Contains(last, "first") || !strings.Contains(last, "second") {
+				t.Errorf("Expected both calls in one batch frame, got %s", last)
+			}
+		})
+	}
+}
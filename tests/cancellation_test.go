@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+func TestCancelledRequestNotifiesServer(t *testing.T) {
+	var mu sync.Mutex
+	var slowID interface{}
+	cancellations := make(chan map[string]interface{}, 1)
+
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch msg.Method {
+		case "tools/call":
+			if slowID == nil {
+				slowID = msg.ID // Never answered in time
+				return
+			}
+			m.push(mcp.NewResponse(msg.ID, echoResult(msg)))
+		case mcp.NotificationCancelled:
+			params, _ := msg.Params.(map[string]interface{})
+			cancellations <- params
+			// A late response to the cancelled request must not be mistaken for the next one
+			m.push(mcp.NewResponse(slowID, map[string]interface{}{
+				"content": []interface{}{map[string]interface{}{"type": "text", "text": "late"}},
+			}))
+		}
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.CallTool(ctx, "slow", nil); err == nil {
+		t.Fatal("Expected error for cancelled request")
+	}
+
+	select {
+	case params := <-cancellations:
+		mu.Lock()
+		id := slowID
+		mu.Unlock()
+		if params["requestId"] != id {
+			t.Errorf("Expected cancellation for request %v, got %v", id, params["requestId"])
+		}
+		if params["reason"] == "" {
+			t.Error("Expected a cancellation reason")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No notifications/cancelled sent")
+	}
+
+	result, err := c.CallTool(context.Background(), "echo", map[string]interface{}{"value": "fresh"})
+	if err != nil {
+		t.Fatalf("CallTool after cancellation failed: %v", err)
+	}
+	if got := toolText(result); got != "fresh" {
+		t.Errorf("Expected %q, got %q", "fresh", got)
+	}
+}
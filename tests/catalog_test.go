@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// catalogServer serves a tool list that tests can change
+type catalogServer struct {
+	mu    sync.Mutex
+	tools []interface{}
+}
+
+func (s *catalogServer) setTools(tools ...map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tools = nil
+	for _, tool := range tools {
+		s.tools = append(s.tools, tool)
+	}
+}
+
+func (s *catalogServer) transport() *mockTransport {
+	return newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		switch msg.Method {
+		case "tools/list":
+			s.mu.Lock()
+			tools := append([]interface{}(nil), s.tools...)
+			s.mu.Unlock()
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{"tools": tools}))
+		case "tools/call":
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{"content": []interface{}{}}))
+		}
+	})
+}
+
+func catalogTool(name, description string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        name,
+		"description": description,
+		"inputSchema": map[string]interface{}{"type": "object"},
+	}
+}
+
+// countRequests counts the requests sent with the given method
+func countRequests(m *mockTransport, method string) int {
+	n := 0
+	for _, msg := range m.sentMessages() {
+		if msg.Method == method {
+			n++
+		}
+	}
+	return n
+}
+
+func TestCatalogLazyLookup(t *testing.T) {
+	server := &catalogServer{}
+	server.setTools(catalogTool("search", "Search the web"), catalogTool("fetch", "Fetch a URL"))
+	m := server.transport()
+	c := newInitializedClient(t, m, client.ClientConfig{CacheCatalog: true})
+	ctx := context.Background()
+
+	if got := countRequests(m, "tools/list"); got != 0 {
+		t.Fatalf("Expected no tools/list before the first lookup, got %d", got)
+	}
+
+	tool, err := c.Tool(ctx, "search")
+	if err != nil {
+		t.Fatalf("Tool failed: %v", err)
+	}
+	if tool.Description != "Search the web" {
+		t.Errorf("Unexpected tool %+v", tool)
+	}
+	if _, err := c.Tool(ctx, "fetch"); err != nil {
+		t.Fatalf("Tool failed: %v", err)
+	}
+	if _, err := c.Tool(ctx, "missing"); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	if got := countRequests(m, "tools/list"); got != 1 {
+		t.Errorf("Expected lookups to share one tools/list, got %d", got)
+	}
+}
+
+func TestCatalogInvalidatedByListChanged(t *testing.T) {
+	server := &catalogServer{}
+	server.setTools(catalogTool("search", "v1"))
+	m := server.transport()
+	c := newInitializedClient(t, m, client.ClientConfig{CacheCatalog: true})
+	ctx := context.Background()
+
+	if _, err := c.Tools(ctx); err != nil {
+		t.Fatalf("Tools failed: %v", err)
+	}
+
+	server.setTools(catalogTool("search", "v2"))
+	m.push(mcp.NewNotification(mcp.NotificationToolListChanged, nil))
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		tool, err := c.Tool(ctx, "search")
+		if err != nil {
+			t.Fatalf("Tool failed: %v", err)
+		}
+		if tool.Description == "v2" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Cache was not invalidated by tools/list_changed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCatalogDiffDelivered(t *testing.T) {
+	server := &catalogServer{}
+	server.setTools(catalogTool("search", "v1"), catalogTool("fetch", "v1"))
+	m := server.transport()
+	c := newInitializedClient(t, m, client.ClientConfig{CacheCatalog: true})
+
+	diffs := make(chan client.CatalogDiff[mcp.Tool], 1)
+	c.OnToolsChanged(func(diff client.CatalogDiff[mcp.Tool]) { diffs <- diff })
+
+	if _, err := c.Tools(context.Background()); err != nil {
+		t.Fatalf("Tools failed: %v", err)
+	}
+
+	server.setTools(catalogTool("search", "v2"), catalogTool("translate", "v1"))
+	m.push(mcp.NewNotification(mcp.NotificationToolListChanged, nil))
+
+	select {
+	case diff := <-diffs:
+		if len(diff.Added) != 1 || diff.Added[0].Name != "translate" {
+			t.Errorf("Unexpected added tools %+v", diff.Added)
+		}
+		if len(diff.Removed) != 1 || diff.Removed[0].Name != "fetch" {
+			t.Errorf("Unexpected removed tools %+v", diff.Removed)
+		}
+		if len(diff.Changed) != 1 || diff.Changed[0].Description != "v2" {
+			t.Errorf("Unexpected changed tools %+v", diff.Changed)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for catalog diff")
+	}
+}
+
+func TestCatalogDisabledByDefault(t *testing.T) {
+	server := &catalogServer{}
+	server.setTools(catalogTool("search", "v1"))
+	m := server.transport()
+	c := newInitializedClient(t, m, client.ClientConfig{})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Tool(ctx, "search"); err != nil {
+			t.Fatalf("Tool failed: %v", err)
+		}
+	}
+	if got := countRequests(m, "tools/list"); got != 2 {
+		t.Errorf("Expected every lookup to list tools without the cache, got %d", got)
+	}
+}
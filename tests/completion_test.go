@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+func TestCompletePromptArgument(t *testing.T) {
+	var params map[string]interface{}
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method != "completion/complete" {
+			return
+		}
+		params, _ = msg.Params.(map[string]interface{})
+		argument, _ := params["argument"].(map[string]interface{})
+		prefix, _ := argument["value"].(string)
+
+		values := []interface{}{}
+		for _, language := range []string{"python", "pytorch", "go"} {
+			if strings.HasPrefix(language, prefix) {
+				values = append(values, language)
+			}
+		}
+		m.push(mcp.NewResponse(msg.ID, map[string]interface{}{
+			"completion": map[string]interface{}{"values": values, "total": len(values), "hasMore": false},
+		}))
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	completion, err := c.CompletePromptArgument(context.Background(), "code_review", "language", "py",
+		map[string]string{"framework": "django"})
+	if err != nil {
+		t.Fatalf("CompletePromptArgument failed: %v", err)
+	}
+	if len(completion.Values) != 2 || completion.Values[0] != "python" || completion.Total != 2 {
+		t.Errorf("Unexpected completion: %+v", completion)
+	}
+
+	ref, _ := params["ref"].(map[string]interface{})
+	if ref["type"] != mcp.CompletionRefPrompt || ref["name"] != "code_review" {
+		t.Errorf("Unexpected ref: %v", ref)
+	}
+	completionContext, _ := params["context"].(map[string]interface{})
+	arguments, _ := completionContext["arguments"].(map[string]interface{})
+	if arguments["framework"] != "django" {
+		t.Errorf("Expected previously filled arguments as context, got %v", params["context"])
+	}
+}
+
+func TestCompleteResourceTemplateError(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "completion/complete" {
+			m.push(mcp.NewErrorResponse(msg.ID, mcp.ErrorCodeMethodNotFound, "Method not found", nil))
+		}
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	_, err := c.Complete(context.Background(), mcp.CompleteRequest{
+		Ref:      mcp.ResourceTemplateReference("file:///{path}"),
+		Argument: mcp.CompletionArgument{Name: "path", Value: "src/"},
+	})
+	if err == nil {
+		t.Fatal("Expected error from server without completion support")
+	}
+}
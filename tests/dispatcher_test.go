@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// echoResult builds a tools/call result echoing the "value" argument
+func echoResult(msg *mcp.Message) map[string]interface{} {
+	params, _ := msg.Params.(map[string]interface{})
+	args, _ := params["arguments"].(map[string]interface{})
+	return map[string]interface{}{
+		"content": []interface{}{
+			map[string]interface{}{"type": "text", "text": fmt.Sprint(args["value"])},
+		},
+	}
+}
+
+func TestConcurrentToolCalls(t *testing.T) {
+	const calls = 5
+
+	// Hold every request until all have arrived, then answer in reverse order
+	var mu sync.Mutex
+	var held []*mcp.Message
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method != "tools/call" {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		held = append(held, msg)
+		if len(held) < calls {
+			return
+		}
+		for i := len(held) - 1; i >= 0; i-- {
+			m.push(mcp.NewResponse(held[i].ID, echoResult(held[i])))
+		}
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, calls)
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			want := fmt.Sprintf("call-%d", i)
+			result, err := c.CallTool(context.Background(), "echo", map[string]interface{}{"value": want})
+			if err != nil {
+				errs <- err
+				return
+			}
+			if got := toolText(result); got != want {
+				errs <- fmt.Errorf("expected %q, got %q", want, got)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestNotificationsDoNotConsumeResponses(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		m.push(mcp.NewNotification("notifications/tools/list_changed", nil))
+		m.push(mcp.NewResponse(msg.ID, echoResult(msg)))
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	result, err := c.CallTool(context.Background(), "echo", map[string]interface{}{"value": "ok"})
+	if err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+	if got := toolText(result); got != "ok" {
+		t.Errorf("expected %q, got %q", "ok", got)
+	}
+}
+
+func TestDisconnectFailsPendingRequests(t *testing.T) {
+	// The server never answers tools/call
+	m := newMockTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{Timeout: time.Minute})
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := c.CallTool(context.Background(), "slow", nil)
+		errCh <- err
+	}()
+
+	// Give the request time to be sent before disconnecting
+	time.Sleep(50 * time.Millisecond)
+	c.Disconnect()
+
+	select {
+	case err := <-errCh:
+		if err == nil {
+			t.Error("Expected error for request pending at disconnect")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Pending request was not released by Disconnect")
+	}
+}
@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+func TestElicitationHandler(t *testing.T) {
+	m, responses := newRecordingTransport()
+
+	handler := client.ElicitationHandlerFunc(func(ctx context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+		if req.Message == "decline me" {
+			// Content must be dropped for non-accept actions
+			return &mcp.ElicitResult{Action: mcp.ElicitActionDecline, Content: map[string]interface{}{"x": 1}}, nil
+		}
+		return &mcp.ElicitResult{
+			Action:  mcp.ElicitActionAccept,
+			Content: map[string]interface{}{"name": "octocat"},
+		}, nil
+	})
+	newInitializedClient(t, m, client.ClientConfig{ElicitationHandler: handler})
+
+	if _, ok := initializeCapabilities(t, m)["elicitation"]; !ok {
+		t.Error("Expected elicitation capability to be advertised")
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"name": map[string]interface{}{"type": "string"}},
+		"required":   []string{"name"},
+	}
+
+	m.push(mcp.NewRequest(11, "elicitation/create", mcp.ElicitRequest{Message: "Your GitHub username?", RequestedSchema: schema}))
+	response := waitForResponse(t, responses, 11)
+	result, _ := response.Result.(map[string]interface{})
+	content, _ := result["content"].(map[string]interface{})
+	if result["action"] != mcp.ElicitActionAccept || content["name"] != "octocat" {
+		t.Errorf("Unexpected accept result: %v", response.Result)
+	}
+
+	m.push(mcp.NewRequest(12, "elicitation/create", mcp.ElicitRequest{Message: "decline me", RequestedSchema: schema}))
+	response = waitForResponse(t, responses, 12)
+	result, _ = response.Result.(map[string]interface{})
+	if result["action"] != mcp.ElicitActionDecline {
+		t.Errorf("Expected decline, got %v", result["action"])
+	}
+	if _, ok := result["content"]; ok {
+		t.Error("Declined result should not carry content")
+	}
+}
+
+func TestElicitationHandlerInvalidResult(t *testing.T) {
+	m, responses := newRecordingTransport()
+
+	handler := client.ElicitationHandlerFunc(func(ctx context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+		if req.Message == "nothing" {
+			return nil, nil
+		}
+		return &mcp.ElicitResult{Action: "maybe"}, nil
+	})
+	newInitializedClient(t, m, client.ClientConfig{ElicitationHandler: handler})
+
+	for id, message := range map[float64]string{21: "nothing", 22: "invalid action"} {
+		m.push(mcp.NewRequest(id, "elicitation/create", mcp.ElicitRequest{Message: message}))
+		response := waitForResponse(t, responses, id)
+		if response.Error == nil || response.Error.Code != mcp.ErrorCodeInternalError {
+			t.Errorf("Expected an internal error for %q, got %+v", message, response)
+		}
+	}
+}
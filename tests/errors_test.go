@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+func TestServerErrorIsMCPError(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "tools/call" {
+			m.push(mcp.NewErrorResponse(msg.ID, mcp.ErrorCodeInvalidParams, "missing argument", map[string]interface{}{"field": "query"}))
+		}
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	_, err := c.CallTool(context.Background(), "search", nil)
+	if err == nil {
+		t.Fatal("Expected CallTool to fail")
+	}
+	if err.Error() != "call tool error: missing argument" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+	if !client.IsErrorCode(err, mcp.ErrorCodeInvalidParams) {
+		t.Errorf("Expected IsErrorCode to match wrapped error %v", err)
+	}
+
+	var mcpErr *client.MCPError
+	if !errors.As(err, &mcpErr) {
+		t.Fatalf("Expected *MCPError in chain, got %T", err)
+	}
+	data, _ := mcpErr.Data.(map[string]interface{})
+	if data["field"] != "query" {
+		t.Errorf("Expected error data to be preserved, got %v", mcpErr.Data)
+	}
+}
+
+func TestNotInitializedError(t *testing.T) {
+	c := client.NewClient(newMockTransport(nil), client.ClientConfig{})
+
+	if _, err := c.ListTools(context.Background()); !errors.Is(err, client.ErrNotInitialized) {
+		t.Errorf("Expected ErrNotInitialized from ListTools, got %v", err)
+	}
+	if _, err := c.CallTool(context.Background(), "x", nil); !errors.Is(err, client.ErrNotInitialized) {
+		t.Errorf("Expected ErrNotInitialized from CallTool, got %v", err)
+	}
+}
+
+func TestRequestTimeoutError(t *testing.T) {
+	m := newMockTransport(nil) // Never answers anything but initialize
+	c := newInitializedClient(t, m, client.ClientConfig{Timeout: 50 * time.Millisecond})
+
+	_, err := c.ListTools(context.Background())
+	if !errors.Is(err, client.ErrTimeout) {
+		t.Fatalf("Expected ErrTimeout, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded in chain, got %v", err)
+	}
+}
+
+func TestConnectionLostError(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "tools/list" {
+			go m.Close()
+		}
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	_, err := c.ListTools(context.Background())
+	if !errors.Is(err, client.ErrConnectionClosed) {
+		t.Fatalf("Expected ErrConnectionClosed, got %v", err)
+	}
+	var transportErr *client.TransportError
+	if !errors.As(err, &transportErr) {
+		t.Errorf("Expected *TransportError in chain, got %v", err)
+	}
+
+	if _, err := c.ListTools(context.Background()); !errors.Is(err, client.ErrNotInitialized) {
+		t.Errorf("Expected ErrNotInitialized after the connection was lost, got %v", err)
+	}
+}
+
+func TestInvalidResponseError(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "tools/list" {
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{"tools": "not-a-list"}))
+		}
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	if _, err := c.ListTools(context.Background()); !errors.Is(err, client.ErrInvalidResponse) {
+		t.Errorf("Expected ErrInvalidResponse, got %v", err)
+	}
+}
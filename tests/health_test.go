@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+func TestPing(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "ping" {
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{}))
+		}
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping failed: %v", err)
+	}
+	if c.Health() != client.HealthHealthy {
+		t.Errorf("Expected healthy after initialize, got %s", c.Health())
+	}
+}
+
+func TestServerPingIsAnswered(t *testing.T) {
+	m, responses := newRecordingTransport()
+	newInitializedClient(t, m, client.ClientConfig{})
+
+	m.push(mcp.NewRequest(21, "ping", nil))
+	response := waitForResponse(t, responses, 21)
+	if response.Error != nil {
+		t.Errorf("Expected empty result for ping, got error %v", response.Error)
+	}
+}
+
+func TestKeepAliveHealthTransitions(t *testing.T) {
+	var answering atomic.Bool
+	answering.Store(true)
+
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "ping" && answering.Load() {
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{}))
+		}
+	})
+
+	transitions := make(chan client.HealthState, 10)
+	c := client.NewClient(m, client.ClientConfig{
+		Timeout: 5 * time.Second,
+		KeepAlive: client.KeepAliveConfig{
+			Interval:         20 * time.Millisecond,
+			FailureThreshold: 2,
+		},
+	})
+	c.OnHealthChange(func(from, to client.HealthState) { transitions <- to })
+
+	ctx := context.Background()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer c.Disconnect()
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	expect := func(want client.HealthState) {
+		t.Helper()
+		select {
+		case got := <-transitions:
+			if got != want {
+				t.Fatalf("Expected transition to %s, got %s", want, got)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Timed out waiting for transition to %s", want)
+		}
+	}
+
+	expect(client.HealthHealthy)
+
+	// Stop answering pings: first failure degrades, second reaches the threshold
+	answering.Store(false)
+	expect(client.HealthDegraded)
+	expect(client.HealthDead)
+
+	// A dead server's connection is closed, even without reconnection
+	deadline := time.Now().Add(2 * time.Second)
+	for c.IsConnected() {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the client to disconnect from a dead server")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := c.Ping(ctx); err == nil {
+		t.Error("Expected Ping to fail once disconnected")
+	}
+
+	// Recovery is reported once connected to a responsive server again
+	answering.Store(true)
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	expect(client.HealthHealthy)
+}
+
+func TestKeepAliveRestartedOnInitialize(t *testing.T) {
+	var pings atomic.Int32
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "ping" {
+			pings.Add(1)
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{}))
+		}
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{
+		KeepAlive: client.KeepAliveConfig{Interval: 50 * time.Millisecond},
+	})
+
+	for i := 0; i < 4; i++ {
+		if err := c.Initialize(context.Background(), mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+			t.Fatalf("Initialize failed: %v", err)
+		}
+	}
+
+	pings.Store(0)
+	time.Sleep(500 * time.Millisecond)
+	if got := pings.Load(); got > 20 {
+		t.Errorf("Expected a single keepalive loop (about 10 pings), got %d pings", got)
+	}
+}
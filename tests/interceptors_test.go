@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// newEchoToolTransport answers tools/call with an empty result
+func newEchoToolTransport() *mockTransport {
+	return newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "tools/call" {
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{"content": []interface{}{}}))
+		}
+	})
+}
+
+// sentParams returns the params of the last request with the given method
+func sentParams(m *mockTransport, method string) map[string]interface{} {
+	var params map[string]interface{}
+	for _, msg := range m.sentMessages() {
+		if msg.Method == method {
+			params, _ = msg.Params.(map[string]interface{})
+		}
+	}
+	return params
+}
+
+func TestRequestInterceptorOrder(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	record := func(name string) client.RequestInterceptor {
+		return func(ctx context.Context, req *client.Request, next client.RequestInvoker) (*mcp.Message, error) {
+			if req.Method != "tools/call" {
+				return next(ctx, req)
+			}
+			mu.Lock()
+			calls = append(calls, name+" before")
+			mu.Unlock()
+			response, err := next(ctx, req)
+			mu.Lock()
+			calls = append(calls, name+" after")
+			mu.Unlock()
+			return response, err
+		}
+	}
+
+	c := newInitializedClient(t, newEchoToolTransport(), client.ClientConfig{
+		RequestInterceptors: []client.RequestInterceptor{record("outer"), record("inner")},
+	})
+	if _, err := c.CallTool(context.Background(), "echo", nil); err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+
+	want := []string{"outer before", "inner before", "inner after", "outer after"}
+	mu.Lock()
+	defer mu.Unlock()
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("Expected %v, got %v", want, calls)
+	}
+}
+
+func TestRequestInterceptorMetaAndRedaction(t *testing.T) {
+	auth := func(ctx context.Context, req *client.Request, next client.RequestInvoker) (*mcp.Message, error) {
+		if err := req.SetMeta("authorization", "Bearer token"); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+	redact := func(ctx context.Context, req *client.Request, next client.RequestInvoker) (*mcp.Message, error) {
+		if params, ok := req.Params.(map[string]interface{}); ok {
+			if args, ok := params["arguments"].(map[string]interface{}); ok {
+				args["password"] = "[redacted]"
+			}
+		}
+		return next(ctx, req)
+	}
+
+	m := newEchoToolTransport()
+	c := client.NewClientBuilder().
+		WithTransport(m).
+		WithTimeout(5*time.Second).
+		WithRequestInterceptor(auth, redact).
+		Build()
+	ctx := context.Background()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer c.Disconnect()
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	_, err := c.CallTool(ctx, "login", map[string]interface{}{"password": "hunter2"},
+		client.WithProgress(func(mcp.ProgressNotification) {}))
+	if err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+
+	params := sentParams(m, "tools/call")
+	meta, _ := params["_meta"].(map[string]interface{})
+	if meta["authorization"] != "Bearer token" {
+		t.Errorf("Expected authorization in _meta, got %v", params["_meta"])
+	}
+	if meta["progressToken"] == nil {
+		t.Error("Expected SetMeta to keep the existing progressToken")
+	}
+	if args, _ := params["arguments"].(map[string]interface{}); args["password"] != "[redacted]" {
+		t.Errorf("Expected redacted password, got %v", params["arguments"])
+	}
+}
+
+func TestRequestInterceptorRetry(t *testing.T) {
+	var mu sync.Mutex
+	attempts := 0
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method != "tools/call" {
+			return
+		}
+		mu.Lock()
+		attempts++
+		first := attempts == 1
+		mu.Unlock()
+		if first {
+			m.push(mcp.NewErrorResponse(msg.ID, mcp.ErrorCodeInternalError, "busy", nil))
+			return
+		}
+		m.push(mcp.NewResponse(msg.ID, map[string]interface{}{"content": []interface{}{}}))
+	})
+
+	retry := func(ctx context.Context, req *client.Request, next client.RequestInvoker) (*mcp.Message, error) {
+		response, err := next(ctx, req)
+		if err == nil && response.Error != nil && response.Error.Code == mcp.ErrorCodeInternalError {
+			return next(ctx, req)
+		}
+		return response, err
+	}
+
+	c := newInitializedClient(t, m, client.ClientConfig{RequestInterceptors: []client.RequestInterceptor{retry}})
+	if _, err := c.CallTool(context.Background(), "flaky", nil); err != nil {
+		t.Fatalf("Expected retry to succeed, got %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestLoggingInterceptorWithoutResponse(t *testing.T) {
+	var logs logRecorder
+	shortCircuit := func(ctx context.Context, req *client.Request, next client.RequestInvoker) (*mcp.Message, error) {
+		if req.Method == "tools/call" {
+			return nil, nil
+		}
+		return next(ctx, req)
+	}
+
+	c := newInitializedClient(t, newEchoToolTransport(), client.ClientConfig{
+		RequestInterceptors: []client.RequestInterceptor{client.LoggingInterceptor(logs.logger(slog.LevelInfo)), shortCircuit},
+	})
+	if _, err := c.CallTool(context.Background(), "echo", nil); !errors.Is(err, client.ErrInvalidResponse) {
+		t.Errorf("Expected ErrInvalidResponse, got %v", err)
+	}
+	if records := logs.find(t, "request returned no response"); len(records) != 1 || records[0]["method"] != "tools/call" {
+		t.Errorf("Expected the missing response to be logged, got %v", records)
+	}
+}
+
+func TestNotificationInterceptorCanDrop(t *testing.T) {
+	dropLogs := func(notification *mcp.Message, next client.NotificationInvoker) {
+		if notification.Method == mcp.NotificationMessage {
+			return
+		}
+		next(notification)
+	}
+
+	m := newMockTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{
+		NotificationInterceptors: []client.NotificationInterceptor{dropLogs},
+	})
+
+	received := make(chan string, 10)
+	c.OnNotification(client.AllNotifications, func(n *mcp.Message) { received <- n.Method })
+
+	m.push(mcp.NewNotification(mcp.NotificationMessage, mcp.LoggingMessageNotification{Level: "info", Data: "x"}))
+	m.push(mcp.NewNotification(mcp.NotificationToolListChanged, nil))
+
+	select {
+	case method := <-received:
+		if method != mcp.NotificationToolListChanged {
+			t.Errorf("Expected the log notification to be dropped, got %s", method)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for notification")
+	}
+}
@@ -0,0 +1,211 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/jsonschema"
+)
+
+var weatherSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"temperature": map[string]interface{}{"type": "number"},
+		"conditions":  map[string]interface{}{"type": "string", "enum": []interface{}{"sunny", "cloudy", "rain"}},
+		"hourly": map[string]interface{}{
+			"type":  "array",
+			"items": map[string]interface{}{"type": "integer"},
+		},
+	},
+	"required":             []interface{}{"temperature", "conditions"},
+	"additionalProperties": false,
+}
+
+func TestSchemaValidateAccepts(t *testing.T) {
+	value := map[string]interface{}{
+		"temperature": 22.5,
+		"conditions":  "sunny",
+		"hourly":      []interface{}{float64(20), float64(21)},
+	}
+	if err := jsonschema.Validate(weatherSchema, value); err != nil {
+		t.Errorf("Expected valid value, got %v", err)
+	}
+	if err := jsonschema.Validate(nil, "anything"); err != nil {
+		t.Errorf("Expected empty schema to accept anything, got %v", err)
+	}
+}
+
+func TestSchemaValidateReportsPointers(t *testing.T) {
+	value := map[string]interface{}{
+		"temperature": "warm",
+		"conditions":  "snow",
+		"hourly":      []interface{}{float64(20), 20.5},
+		"extra":       true,
+	}
+
+	err := jsonschema.Validate(weatherSchema, value)
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected *ValidationError, got %v", err)
+	}
+
+	want := map[string]bool{"/temperature": true, "/conditions": true, "/hourly/1": true, "/extra": true}
+	for _, fieldErr := range validationErr.Errors {
+		if !want[fieldErr.Pointer] {
+			t.Errorf("Unexpected error %v", fieldErr)
+		}
+		delete(want, fieldErr.Pointer)
+	}
+	for pointer := range want {
+		t.Errorf("Missing error for %s", pointer)
+	}
+}
+
+func TestSchemaValidateRequired(t *testing.T) {
+	err := jsonschema.Validate(weatherSchema, map[string]interface{}{"temperature": float64(1)})
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Errors) != 1 || validationErr.Errors[0].Pointer != "/conditions" {
+		t.Fatalf("Expected a single required error for /conditions, got %v", err)
+	}
+
+	if err := jsonschema.Validate(weatherSchema, []interface{}{}); err == nil {
+		t.Error("Expected type error for an array")
+	}
+}
+
+// failingPointers validates value and returns the pointers of every failure
+func failingPointers(t *testing.T, schema map[string]interface{}, value interface{}) map[string]bool {
+	t.Helper()
+	err := jsonschema.Validate(schema, value)
+	pointers := make(map[string]bool)
+	if err == nil {
+		return pointers
+	}
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected *ValidationError, got %v", err)
+	}
+	for _, fieldErr := range validationErr.Errors {
+		pointers[fieldErr.Pointer] = true
+	}
+	return pointers
+}
+
+func TestSchemaValidateBounds(t *testing.T) {
+	schema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"count":    map[string]interface{}{"type": "integer", "minimum": float64(1), "maximum": float64(10)},
+			"ratio":    map[string]interface{}{"type": "number", "exclusiveMinimum": float64(0), "exclusiveMaximum": float64(1)},
+			"step":     map[string]interface{}{"type": "number", "multipleOf": 0.5},
+			"name":     map[string]interface{}{"type": "string", "minLength": float64(2), "maxLength": float64(4)},
+			"code":     map[string]interface{}{"type": "string", "pattern": "^[A-Z]{3}$"},
+			"tags":     map[string]interface{}{"type": "array", "minItems": float64(1), "maxItems": float64(2), "uniqueItems": true},
+			"legacyLo": map[string]interface{}{"type": "number", "minimum": float64(0), "exclusiveMinimum": true},
+		},
+	}
+
+	valid := map[string]interface{}{
+		"count": float64(10), "ratio": 0.5, "step": 1.5, "name": "über",
+		"code": "ABC", "tags": []interface{}{"a", "b"}, "legacyLo": 0.1,
+	}
+	if pointers := failingPointers(t, schema, valid); len(pointers) != 0 {
+		t.Errorf("Expected valid value, got failures at %v", pointers)
+	}
+
+	invalid := map[string]interface{}{
+		"count": float64(11), "ratio": float64(1), "step": 1.2, "name": "a",
+		"code": "abc", "tags": []interface{}{"a", "a"}, "legacyLo": float64(0),
+	}
+	want := []string{"/count", "/ratio", "/step", "/name", "/code", "/tags/1", "/legacyLo"}
+	pointers := failingPointers(t, schema, invalid)
+	for _, pointer := range want {
+		if !pointers[pointer] {
+			t.Errorf("Missing failure at %s (got %v)", pointer, pointers)
+		}
+	}
+	if len(pointers) != len(want) {
+		t.Errorf("Expected %d failures, got %v", len(want), pointers)
+	}
+}
+
+func TestSchemaValidateCombinators(t *testing.T) {
+	schema := map[string]interface{}{
+		"anyOf": []interface{}{
+			map[string]interface{}{"type": "string"},
+			map[string]interface{}{"type": "number"},
+		},
+		"not": map[string]interface{}{"const": "forbidden"},
+	}
+	for _, value := range []interface{}{"ok", float64(3)} {
+		if err := jsonschema.Validate(schema, value); err != nil {
+			t.Errorf("Expected %v to be valid, got %v", value, err)
+		}
+	}
+	for _, value := range []interface{}{true, "forbidden"} {
+		if err := jsonschema.Validate(schema, value); err == nil {
+			t.Errorf("Expected %v to be invalid", value)
+		}
+	}
+
+	oneOf := map[string]interface{}{"oneOf": []interface{}{
+		map[string]interface{}{"type": "integer"},
+		map[string]interface{}{"type": "number", "minimum": float64(10)},
+	}}
+	if err := jsonschema.Validate(oneOf, float64(5)); err != nil {
+		t.Errorf("Expected exactly one match for 5, got %v", err)
+	}
+	if err := jsonschema.Validate(oneOf, float64(20)); err == nil {
+		t.Error("Expected 20 to match both oneOf schemas and fail")
+	}
+
+	allOf := map[string]interface{}{"allOf": []interface{}{
+		map[string]interface{}{"minLength": float64(2)},
+		map[string]interface{}{"pattern": "^a"},
+	}}
+	if pointers := failingPointers(t, allOf, "b"); len(pointers) != 1 || !pointers[""] {
+		t.Errorf("Expected allOf failures at the root, got %v", pointers)
+	}
+}
+
+func TestSchemaValidateNestedRefs(t *testing.T) {
+	schema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"shipping": map[string]interface{}{"$ref": "#/$defs/address"},
+			"stops": map[string]interface{}{
+				"type":  "array",
+				"items": map[string]interface{}{"$ref": "#/$defs/address"},
+			},
+		},
+		"$defs": map[string]interface{}{
+			"address": map[string]interface{}{
+				"type":     "object",
+				"required": []interface{}{"city"},
+				"properties": map[string]interface{}{
+					"city": map[string]interface{}{"type": "string"},
+					"zip":  map[string]interface{}{"type": "string", "pattern": "^[0-9]{5}$"},
+				},
+			},
+		},
+	}
+
+	value := map[string]interface{}{
+		"shipping": map[string]interface{}{"zip": "1234"},
+		"stops": []interface{}{
+			map[string]interface{}{"city": "Oslo"},
+			map[string]interface{}{"city": float64(7)},
+		},
+	}
+	pointers := failingPointers(t, schema, value)
+	for _, pointer := range []string{"/shipping/city", "/shipping/zip", "/stops/1/city"} {
+		if !pointers[pointer] {
+			t.Errorf("Missing failure at %s (got %v)", pointer, pointers)
+		}
+	}
+
+	// A schema that refers to itself without consuming the value must terminate
+	if err := jsonschema.Validate(map[string]interface{}{"$ref": "#"}, "x"); err == nil {
+		t.Error("Expected a self-referencing schema to fail instead of recursing")
+	}
+}
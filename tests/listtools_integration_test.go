@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -39,11 +40,11 @@ func TestListToolsWithMockServer(t *testing.T) {
 		Version: "1.0.0",
 	}
 
-	serverInfo, err := c.Initialize(ctx, clientInfo)
-	if err != nil {
+	if err := c.Initialize(ctx, clientInfo); err != nil {
 		t.Fatalf("Initialize failed: %v", err)
 	}
 
+	serverInfo := c.GetServerInfo()
 	t.Logf("Connected to server: %s %s", serverInfo.Name, serverInfo.Version)
 
 	tools, err := c.ListTools(ctx)
@@ -113,9 +114,7 @@ func TestToolNameValidation(t *testing.T) {
 			// For the 128-char test, fill with valid chars
 			testName := tt.toolName
 			if len(tt.toolName) == 128 && !tt.expectErr {
-				for i := 0; i < 128; i++ {
-					testName = "a" + testName[1:]
-				}
+				testName = strings.Repeat("a", 128)
 			}
 
 			err := mcp.ValidateToolName(testName)
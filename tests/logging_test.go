@@ -0,0 +1,239 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+)
+
+func TestSetLogLevelAndSink(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "logging/setLevel" {
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{}))
+			m.push(mcp.NewNotification(mcp.NotificationMessage, mcp.LoggingMessageNotification{
+				Level: mcp.LogLevelWarning, Logger: "db", Data: map[string]interface{}{"slow_query_ms": 1200},
+			}))
+		}
+	})
+	m.capabilities = map[string]interface{}{"logging": map[string]interface{}{}}
+
+	entries := make(chan mcp.LoggingMessageNotification, 1)
+	c := newInitializedClient(t, m, client.ClientConfig{
+		LogSink: client.LogSinkFunc(func(entry mcp.LoggingMessageNotification) { entries <- entry }),
+	})
+
+	if err := c.SetLogLevel(context.Background(), mcp.LogLevelDebug); err != nil {
+		t.Fatalf("SetLogLevel failed: %v", err)
+	}
+
+	var level interface{}
+	for _, msg := range m.sentMessages() {
+		if msg.Method == "logging/setLevel" {
+			level = msg.Params.(map[string]interface{})["level"]
+		}
+	}
+	if level != "debug" {
+		t.Errorf("Expected level %q sent, got %v", "debug", level)
+	}
+
+	select {
+	case entry := <-entries:
+		if entry.Level != mcp.LogLevelWarning || entry.Logger != "db" {
+			t.Errorf("Unexpected entry: %+v", entry)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Log entry not delivered to sink")
+	}
+}
+
+func TestSetLogLevelValidation(t *testing.T) {
+	c := newInitializedClient(t, newMockTransport(nil), client.ClientConfig{})
+
+	if err := c.SetLogLevel(context.Background(), mcp.LogLevelInfo); !errors.Is(err, client.ErrCapabilityNotSupported) {
+		t.Errorf("Expected ErrCapabilityNotSupported when server lacks the logging capability, got %v", err)
+	}
+	if err := c.SetLogLevel(context.Background(), "verbose"); err == nil {
+		t.Error("Expected error for unknown log level")
+	}
+	if _, err := mcp.ParseLogLevel("WARNING"); err != nil {
+		t.Errorf("ParseLogLevel should accept any case: %v", err)
+	}
+}
+
+func TestSlogLogSink(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	sink := client.NewSlogLogSink(logger)
+
+	sink.HandleLog(mcp.LoggingMessageNotification{Level: mcp.LogLevelError, Logger: "api", Data: "request failed"})
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("Invalid slog output %q: %v", buf.String(), err)
+	}
+	if record["level"] != "ERROR" || record["msg"] != "request failed" || record["logger"] != "api" {
+		t.Errorf("Unexpected slog record: %v", record)
+	}
+
+	if client.SlogLevel(mcp.LogLevelCritical) <= slog.LevelError {
+		t.Error("Expected critical to map above slog error")
+	}
+}
+
+func TestWriterLogSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := client.NewWriterLogSink(&buf)
+
+	sink.HandleLog(mcp.LoggingMessageNotification{Level: mcp.LogLevelInfo, Data: "started"})
+	sink.HandleLog(mcp.LoggingMessageNotification{Level: mcp.LogLevelDebug, Data: map[string]interface{}{"k": "v"}})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 JSON lines, got %q", buf.String())
+	}
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("Invalid JSON line %q: %v", lines[0], err)
+	}
+	if record["level"] != "info" || record["data"] != "started" || record["time"] == nil {
+		t.Errorf("Unexpected record: %v", record)
+	}
+}
+
+// logRecorder collects JSON slog records written from any goroutine
+type logRecorder struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (r *logRecorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.buf.Write(p)
+}
+
+// logger returns a JSON logger writing to the recorder at level
+func (r *logRecorder) logger(level slog.Level) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(r, &slog.HandlerOptions{Level: level}))
+}
+
+// find returns the records with the given message
+func (r *logRecorder) find(t *testing.T, msg string) []map[string]interface{} {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var found []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(r.buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Invalid slog output %q: %v", line, err)
+		}
+		if record["msg"] == msg {
+			found = append(found, record)
+		}
+	}
+	return found
+}
+
+func TestClientStructuredLogs(t *testing.T) {
+	var logs logRecorder
+	c := newInitializedClient(t, newEchoToolTransport(), client.ClientConfig{Logger: logs.logger(slog.LevelDebug)})
+
+	if _, err := c.CallTool(context.Background(), "echo", nil); err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+
+	var sent map[string]interface{}
+	for _, record := range logs.find(t, "sending request") {
+		if record["method"] == "tools/call" {
+			sent = record
+		}
+	}
+	if sent == nil {
+		t.Fatal("Expected a debug record for the tools/call request")
+	}
+	if sent["transport"] != "custom" || sent["server"] != "mock-server" || sent["request_id"] == nil {
+		t.Errorf("Expected transport, server and request_id attributes, got %v", sent)
+	}
+}
+
+func TestClientDebugLogsGatedByLevel(t *testing.T) {
+	var logs logRecorder
+	c := newInitializedClient(t, newEchoToolTransport(), client.ClientConfig{Logger: logs.logger(slog.LevelInfo)})
+
+	if _, err := c.CallTool(context.Background(), "echo", nil); err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+	if records := logs.find(t, "sending request"); len(records) != 0 {
+		t.Errorf("Expected no debug records at info level, got %v", records)
+	}
+}
+
+func TestClientSilentWithoutLogger(t *testing.T) {
+	var logs logRecorder
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(logs.logger(slog.LevelDebug))
+
+	m := newMockTransport(nil) // Never answers tools/call
+	c := newInitializedClient(t, m, client.ClientConfig{Timeout: 50 * time.Millisecond, Reconnect: fastReconnect})
+	events := recordEvents(c)
+
+	if _, err := c.CallTool(context.Background(), "echo", nil); !errors.Is(err, client.ErrTimeout) {
+		t.Fatalf("Expected CallTool to time out, got %v", err)
+	}
+	m.Close()
+	waitForEvent(t, events, client.ConnectionRestored)
+
+	logs.mu.Lock()
+	defer logs.mu.Unlock()
+	if logs.buf.Len() != 0 {
+		t.Errorf("Expected no output without a configured Logger, got:\n%s", logs.buf.String())
+	}
+}
+
+func TestTransportPayloadLogs(t *testing.T) {
+	server := newStreamingTestServer(nil)
+	defer server.Close()
+
+	var logs logRecorder
+	c := client.NewClient(transport.NewStreamingHTTPTransport(server.URL, "/mcp"), client.ClientConfig{
+		Logger: logs.logger(slog.LevelDebug),
+	})
+	ctx := context.Background()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer c.Disconnect()
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	sent := logs.find(t, "sent message")
+	if len(sent) == 0 {
+		t.Fatal("Expected the transport to log sent messages")
+	}
+	first := sent[0]
+	if first["transport"] != "http" || first["method"] != "initialize" {
+		t.Errorf("Unexpected record %v", first)
+	}
+	if payload, _ := first["payload"].(string); !strings.Contains(payload, `"clientInfo"`) {
+		t.Errorf("Expected the message payload, got %v", first["payload"])
+	}
+	if len(logs.find(t, "received message")) == 0 {
+		t.Error("Expected the transport to log received messages")
+	}
+}
@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/metrics"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+)
+
+// scrape returns the registry's metrics as served by its handler
+func scrape(t *testing.T, registry *metrics.Registry) string {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	registry.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if got := recorder.Header().Get("Content-Type"); got != metrics.ContentType {
+		t.Errorf("Expected content type %s, got %s", metrics.ContentType, got)
+	}
+	return recorder.Body.String()
+}
+
+// expectLines fails unless every line appears in the exposition text
+func expectLines(t *testing.T, text string, lines ...string) {
+	t.Helper()
+	for _, line := range lines {
+		if !strings.Contains("\n"+text, "\n"+line+"\n") {
+			t.Errorf("Expected line %q in:\n%s", line, text)
+		}
+	}
+}
+
+func TestRegistryTextFormat(t *testing.T) {
+	registry := metrics.NewRegistry()
+	requests := registry.Counter("app_requests_total", "Requests handled.", "method")
+	requests.With("b").Add(2)
+	requests.With("a").Inc()
+	registry.Gauge("app_temperature", "Line one\nline two.").With().Set(-1.5)
+	latency := registry.Histogram("app_latency_seconds", "", []float64{1, 0.1}, "path")
+	latency.With(`say "hi"`).Observe(0.05)
+	latency.With(`say "hi"`).Observe(0.5)
+	latency.With(`say "hi"`).Observe(3)
+
+	var buf bytes.Buffer
+	if err := registry.WriteText(&buf); err != nil {
+		t.Fatalf("WriteText failed: %v", err)
+	}
+
+	want := `# TYPE app_latency_seconds histogram
+app_latency_seconds_bucket{path="say \"hi\"",le="0.1"} 1
+app_latency_seconds_bucket{path="say \"hi\"",le="1"} 2
+app_latency_seconds_bucket{path="say \"hi\"",le="+Inf"} 3
+app_latency_seconds_sum{path="say \"hi\""} 3.55
+app_latency_seconds_count{path="say \"hi\""} 3
+# HELP app_requests_total Requests handled.
+# TYPE app_requests_total counter
+app_requests_total{method="a"} 1
+app_requests_total{method="b"} 2
+# HELP app_temperature Line one\nline two.
+# TYPE app_temperature gauge
+app_temperature -1.5
+`
+	if buf.String() != want {
+		t.Errorf("Unexpected exposition text:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRegistryReuseAndConflicts(t *testing.T) {
+	registry := metrics.NewRegistry()
+	registry.Counter("shared_total", "", "client").With("a").Inc()
+	registry.Counter("shared_total", "", "client").With("a").Inc()
+	if got := registry.Counter("shared_total", "", "client").With("a").Value(); got != 2 {
+		t.Errorf("Expected re-registration to share the series, got %v", got)
+	}
+
+	for name, register := range map[string]func(){
+		"type change":  func() { registry.Gauge("shared_total", "", "client") },
+		"label change": func() { registry.Counter("shared_total", "", "server") },
+		"bad name":     func() { registry.Counter("bad-name", "") },
+		"label count":  func() { registry.Counter("shared_total", "", "client").With("a", "b") },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected %s to panic", name)
+				}
+			}()
+			register()
+		}()
+	}
+}
+
+func TestClientMetrics(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method != "tools/call" {
+			return
+		}
+		params, _ := msg.Params.(map[string]interface{})
+		if params["name"] == "broken" {
+			m.push(mcp.NewErrorResponse(msg.ID, mcp.ErrorCodeInvalidParams, "bad arguments", nil))
+			return
+		}
+		m.push(mcp.NewResponse(msg.ID, map[string]interface{}{"content": []interface{}{}}))
+	})
+	registry := metrics.NewRegistry()
+	c := newInitializedClient(t, m, client.ClientConfig{Name: "agent", Metrics: registry, Reconnect: fastReconnect})
+	events := recordEvents(c)
+	ctx := context.Background()
+
+	if _, err := c.CallTool(ctx, "echo", nil); err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+	if _, err := c.CallTool(ctx, "broken", nil); err == nil {
+		t.Fatal("Expected CallTool to fail")
+	}
+
+	m.Close()
+	waitForEvent(t, events, client.ConnectionRestored)
+
+	text := scrape(t, registry)
+	expectLines(t, text,
+		`mcp_client_requests_total{client="agent",transport="custom",method="tools/call"} 2`,
+		`mcp_client_request_errors_total{client="agent",transport="custom",method="tools/call",code="-32602"} 1`,
+		`mcp_client_request_duration_seconds_count{client="agent",transport="custom",method="tools/call"} 2`,
+		`mcp_client_requests_in_flight{client="agent",transport="custom",method="tools/call"} 0`,
+		`mcp_client_connection_events_total{client="agent",transport="custom",event="lost"} 1`,
+		`mcp_client_connection_events_total{client="agent",transport="custom",event="restored"} 1`,
+	)
+	if !strings.Contains(text, `mcp_client_requests_total{client="agent",transport="custom",method="initialize"} 2`) {
+		t.Errorf("Expected the initialize requests to be counted, including the reconnect:\n%s", text)
+	}
+}
+
+func TestTransportMetrics(t *testing.T) {
+	server := newStreamingTestServer(nil)
+	defer server.Close()
+
+	registry := metrics.NewRegistry()
+	c := client.NewClient(transport.NewStreamingHTTPTransport(server.URL, "/mcp"), client.ClientConfig{Metrics: registry})
+	ctx := context.Background()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer c.Disconnect()
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	if _, err := c.CallTool(ctx, "search", nil); err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+
+	// initialize, notifications/initialized and tools/call out; two responses back
+	expectLines(t, scrape(t, registry),
+		`mcp_transport_messages_total{transport="http",direction="received"} 2`,
+		`mcp_transport_messages_total{transport="http",direction="sent"} 3`,
+	)
+}
@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+)
+
+// mockTransport is an in-memory transport backed by a scripted server.
+// Every message sent by the client is round-tripped through JSON, as it
+// would be on the wire, and passed to the handler.
+type mockTransport struct {
+	mu        sync.Mutex
+	connected bool
+	incoming  chan *mcp.Message
+	closed    chan struct{}
+	sent      []*mcp.Message
+	handler   func(m *mockTransport, msg *mcp.Message)
+
+	// capabilities are the server capabilities returned from initialize
+	capabilities map[string]interface{}
+
+	// protocolVersion is returned from initialize; when empty the server
+	// accepts whatever version the client requested
+	protocolVersion string
+
+	// failConnects makes that many following Connect calls fail
+	failConnects int
+
+	// failSends makes that many following Send calls fail
+	failSends int
+}
+
+// newMockTransport creates a transport whose server answers initialize
+// and passes every other message to handler (which may be nil)
+func newMockTransport(handler func(m *mockTransport, msg *mcp.Message)) *mockTransport {
+	return &mockTransport{
+		incoming: make(chan *mcp.Message, 100),
+		closed:   make(chan struct{}),
+		handler:  handler,
+		capabilities: map[string]interface{}{
+			"tools": map[string]interface{}{},
+		},
+	}
+}
+
+func (m *mockTransport) Connect(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failConnects > 0 {
+		m.failConnects--
+		return fmt.Errorf("connection refused")
+	}
+	select {
+	case <-m.closed:
+		m.closed = make(chan struct{}) // Reconnecting after Close
+	default:
+	}
+	m.connected = true
+	return nil
+}
+
+func (m *mockTransport) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.connected {
+		m.connected = false
+		close(m.closed)
+	}
+	return nil
+}
+
+func (m *mockTransport) Send(message *mcp.Message) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	var wire mcp.Message
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	if !m.connected {
+		m.mu.Unlock()
+		return fmt.Errorf("transport not connected")
+	}
+	if m.failSends > 0 {
+		m.failSends--
+		m.mu.Unlock()
+		return fmt.Errorf("broken pipe")
+	}
+	m.sent = append(m.sent, &wire)
+	m.mu.Unlock()
+
+	if wire.Method == "initialize" {
+		version := m.protocolVersion
+		if version == "" {
+			params, _ := wire.Params.(map[string]interface{})
+			version, _ = params["protocolVersion"].(string)
+		}
+		m.push(mcp.NewResponse(wire.ID, map[string]interface{}{
+			"protocolVersion": version,
+			"capabilities":    m.capabilities,
+			"serverInfo":      map[string]interface{}{"name": "mock-server", "version": "1.0.0"},
+		}))
+		return nil
+	}
+
+	if m.handler != nil {
+		m.handler(m, &wire)
+	}
+	return nil
+}
+
+func (m *mockTransport) Receive() (*mcp.Message, error) {
+	m.mu.Lock()
+	closed := m.closed
+	m.mu.Unlock()
+
+	select {
+	case msg := <-m.incoming:
+		return msg, nil
+	case <-closed:
+		return nil, fmt.Errorf("transport closed")
+	}
+}
+
+func (m *mockTransport) GetReader() io.Reader { return nil }
+func (m *mockTransport) GetWriter() io.Writer { return nil }
+
+func (m *mockTransport) IsConnected() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.connected
+}
+
+// push delivers a server message to the client, round-tripped through JSON
+func (m *mockTransport) push(message *mcp.Message) {
+	data, _ := json.Marshal(message)
+	var wire mcp.Message
+	json.Unmarshal(data, &wire)
+	m.incoming <- &wire
+}
+
+// sentMessages returns a copy of everything the client has sent
+func (m *mockTransport) sentMessages() []*mcp.Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*mcp.Message(nil), m.sent...)
+}
+
+// newInitializedClient connects and initializes a client over the transport,
+// usually a mock transport
+func newInitializedClient(t *testing.T, m transport.Transport, config client.ClientConfig) *client.Client {
+	t.Helper()
+
+	if config.Timeout == 0 {
+		config.Timeout = 5 * time.Second
+	}
+	c := client.NewClient(m, config)
+
+	ctx := context.Background()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	t.Cleanup(func() { c.Disconnect() })
+
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	return c
+}
+
+// toolText returns the text of the first content item
+func toolText(result *mcp.CallToolResponse) string {
+	if result == nil || len(result.Content) == 0 {
+		return ""
+	}
+	return result.Content[0].Text
+}
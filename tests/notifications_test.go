@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+func TestNotificationSubscriptions(t *testing.T) {
+	m := newMockTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	toolsChanged := make(chan struct{}, 1)
+	updates := make(chan mcp.ResourceUpdatedNotification, 1)
+	logs := make(chan mcp.LoggingMessageNotification, 1)
+	progress := make(chan mcp.ProgressNotification, 1)
+	all := make(chan string, 10)
+
+	c.OnToolsListChanged(func() { toolsChanged <- struct{}{} })
+	c.OnResourceUpdated(func(n mcp.ResourceUpdatedNotification) { updates <- n })
+	c.OnLogMessage(func(n mcp.LoggingMessageNotification) { logs <- n })
+	c.OnProgress(func(n mcp.ProgressNotification) { progress <- n })
+	unsubscribe := c.OnNotification(client.AllNotifications, func(n *mcp.Message) { all <- n.Method })
+
+	m.push(mcp.NewNotification(mcp.NotificationToolListChanged, nil))
+	m.push(mcp.NewNotification(mcp.NotificationResourceUpdated, mcp.ResourceUpdatedNotification{URI: "file:///a.txt"}))
+	m.push(mcp.NewNotification(mcp.NotificationMessage, mcp.LoggingMessageNotification{Level: "info", Logger: "db", Data: "ready"}))
+	m.push(mcp.NewNotification(mcp.NotificationProgress, mcp.ProgressNotification{ProgressToken: "t1", Progress: 5, Total: 10}))
+
+	timeout := time.After(2 * time.Second)
+	select {
+	case <-toolsChanged:
+	case <-timeout:
+		t.Fatal("tools list_changed handler not called")
+	}
+	select {
+	case n := <-updates:
+		if n.URI != "file:///a.txt" {
+			t.Errorf("Unexpected resource update: %+v", n)
+		}
+	case <-timeout:
+		t.Fatal("resource updated handler not called")
+	}
+	select {
+	case n := <-logs:
+		if n.Level != "info" || n.Logger != "db" || n.Data != "ready" {
+			t.Errorf("Unexpected log message: %+v", n)
+		}
+	case <-timeout:
+		t.Fatal("log message handler not called")
+	}
+	select {
+	case n := <-progress:
+		if n.Progress != 5 || n.Total != 10 {
+			t.Errorf("Unexpected progress: %+v", n)
+		}
+	case <-timeout:
+		t.Fatal("progress handler not called")
+	}
+
+	for i := 0; i < 4; i++ {
+		select {
+		case <-all:
+		case <-timeout:
+			t.Fatalf("catch-all handler received %d of 4 notifications", i)
+		}
+	}
+
+	// After unsubscribing, the catch-all handler gets nothing more
+	unsubscribe()
+	m.push(mcp.NewNotification(mcp.NotificationToolListChanged, nil))
+	<-toolsChanged
+	select {
+	case method := <-all:
+		t.Errorf("Unsubscribed handler still received %s", method)
+	default:
+	}
+}
@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// newPagingTransport serves pageCount pages of two tools each; an endless
+// server keeps returning cursors forever
+func newPagingTransport(pageCount int, endless bool) *mockTransport {
+	return newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method != "tools/list" {
+			return
+		}
+
+		page := 0
+		if params, ok := msg.Params.(map[string]interface{}); ok {
+			if cursor, _ := params["cursor"].(string); cursor != "" {
+				fmt.Sscanf(cursor, "page-%d", &page)
+			}
+		}
+
+		tools := []interface{}{}
+		for i := 0; i < 2; i++ {
+			tools = append(tools, map[string]interface{}{
+				"name":        fmt.Sprintf("tool-%d-%d", page, i),
+				"inputSchema": map[string]interface{}{"type": "object"},
+			})
+		}
+		result := map[string]interface{}{"tools": tools}
+		if endless || page+1 < pageCount {
+			result["nextCursor"] = fmt.Sprintf("page-%d", page+1)
+		}
+		m.push(mcp.NewResponse(msg.ID, result))
+	})
+}
+
+func TestListToolsFollowsCursors(t *testing.T) {
+	m := newPagingTransport(3, false)
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	tools, err := c.ListTools(context.Background())
+	if err != nil {
+		t.Fatalf("ListTools failed: %v", err)
+	}
+	if len(tools) != 6 {
+		t.Fatalf("Expected 6 tools across 3 pages, got %d", len(tools))
+	}
+	if tools[5].Name != "tool-2-1" {
+		t.Errorf("Expected last tool %q, got %q", "tool-2-1", tools[5].Name)
+	}
+}
+
+func TestListToolsMaxPages(t *testing.T) {
+	m := newPagingTransport(0, true)
+	c := newInitializedClient(t, m, client.ClientConfig{MaxPages: 4})
+
+	_, err := c.ListTools(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "4 pages") {
+		t.Fatalf("Expected max pages error, got %v", err)
+	}
+}
+
+func TestListToolsPageExposesCursor(t *testing.T) {
+	m := newPagingTransport(2, false)
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	first, err := c.ListToolsPage(context.Background(), "")
+	if err != nil {
+		t.Fatalf("ListToolsPage failed: %v", err)
+	}
+	if first.NextCursor != "page-1" {
+		t.Fatalf("Expected next cursor %q, got %q", "page-1", first.NextCursor)
+	}
+
+	second, err := c.ListToolsPage(context.Background(), first.NextCursor)
+	if err != nil {
+		t.Fatalf("ListToolsPage failed: %v", err)
+	}
+	if second.NextCursor != "" || second.Tools[0].Name != "tool-1-0" {
+		t.Errorf("Unexpected last page: cursor=%q tools=%v", second.NextCursor, second.Tools)
+	}
+}
+
+func TestToolsIteratorFetchesLazily(t *testing.T) {
+	m := newPagingTransport(3, false)
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	countListRequests := func() int {
+		n := 0
+		for _, msg := range m.sentMessages() {
+			if msg.Method == "tools/list" {
+				n++
+			}
+		}
+		return n
+	}
+
+	it := c.ToolsIterator(context.Background())
+	if !it.Next() || it.Item().Name != "tool-0-0" {
+		t.Fatalf("Expected first tool, got %q (err %v)", it.Item().Name, it.Err())
+	}
+	if got := countListRequests(); got != 1 {
+		t.Errorf("Expected 1 page fetched, got %d", got)
+	}
+	if it.Cursor() != "page-1" {
+		t.Errorf("Expected resume cursor %q, got %q", "page-1", it.Cursor())
+	}
+
+	count := 1
+	for it.Next() {
+		count++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Iterator failed: %v", err)
+	}
+	if count != 6 || countListRequests() != 3 {
+		t.Errorf("Expected 6 tools from 3 pages, got %d tools from %d pages", count, countListRequests())
+	}
+}
@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+func TestCallToolProgress(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method != "tools/call" {
+			return
+		}
+		params, _ := msg.Params.(map[string]interface{})
+		meta, _ := params["_meta"].(map[string]interface{})
+		token := meta["progressToken"]
+
+		// Progress for another request must not reach this call
+		m.push(mcp.NewNotification(mcp.NotificationProgress, mcp.ProgressNotification{ProgressToken: "other", Progress: 99}))
+		for i := 1; i <= 3; i++ {
+			m.push(mcp.NewNotification(mcp.NotificationProgress, mcp.ProgressNotification{
+				ProgressToken: token,
+				Progress:      float64(i),
+				Total:         3,
+				Message:       "indexing",
+			}))
+		}
+		m.push(mcp.NewResponse(msg.ID, echoResult(msg)))
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	var updates []mcp.ProgressNotification
+	_, err := c.CallTool(context.Background(), "index", nil, client.WithProgress(func(p mcp.ProgressNotification) {
+		updates = append(updates, p)
+	}))
+	if err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+
+	if len(updates) != 3 {
+		t.Fatalf("Expected 3 progress updates, got %d: %+v", len(updates), updates)
+	}
+	for i, p := range updates {
+		if p.Progress != float64(i+1) || p.Total != 3 || p.Message != "indexing" {
+			t.Errorf("Unexpected update %d: %+v", i, p)
+		}
+	}
+}
+
+func TestCallToolWithoutProgressOmitsMeta(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		m.push(mcp.NewResponse(msg.ID, echoResult(msg)))
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	if _, err := c.CallTool(context.Background(), "echo", nil); err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+
+	for _, msg := range m.sentMessages() {
+		if msg.Method != "tools/call" {
+			continue
+		}
+		params, _ := msg.Params.(map[string]interface{})
+		if _, ok := params["_meta"]; ok {
+			t.Errorf("Expected no _meta without progress option, got %v", params["_meta"])
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package tests
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+)
+
+// stdioServerEnv makes the test binary run as a stdio MCP server, so tests
+// can start a real server process with transport.NewStdioTransport
+const stdioServerEnv = "MCP_NAVIGATOR_TEST_STDIO_SERVER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(stdioServerEnv) != "" {
+		runStdioServer()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runStdioServer answers initialize and ping on stdin/stdout until stdin
+// closes; calling the "crash" tool makes it exit as if it had crashed
+func runStdioServer() {
+	scanner := bufio.NewScanner(os.Stdin)
+	encoder := json.NewEncoder(os.Stdout)
+	for scanner.Scan() {
+		var msg mcp.Message
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil || msg.ID == nil {
+			continue
+		}
+		switch msg.Method {
+		case "initialize":
+			params, _ := msg.Params.(map[string]interface{})
+			encoder.Encode(mcp.NewResponse(msg.ID, map[string]interface{}{
+				"protocolVersion": params["protocolVersion"],
+				"capabilities":    map[string]interface{}{"tools": map[string]interface{}{}},
+				"serverInfo":      map[string]interface{}{"name": "stdio-server", "version": "1.0.0"},
+			}))
+		case "ping":
+			encoder.Encode(mcp.NewResponse(msg.ID, map[string]interface{}{}))
+		case "tools/call":
+			os.Exit(1)
+		default:
+			encoder.Encode(mcp.NewErrorResponse(msg.ID, mcp.ErrorCodeMethodNotFound, "method not found", nil))
+		}
+	}
+}
+
+// fastReconnect retries quickly so tests don't wait on backoff
+var fastReconnect = client.ReconnectConfig{
+	MaxAttempts:  3,
+	InitialDelay: 10 * time.Millisecond,
+	MaxDelay:     20 * time.Millisecond,
+}
+
+// recordEvents collects connection events on a channel
+func recordEvents(c *client.Client) <-chan client.ConnectionEvent {
+	events := make(chan client.ConnectionEvent, 32)
+	c.OnConnectionEvent(func(event client.ConnectionEvent) {
+		events <- event
+	})
+	return events
+}
+
+// waitForEvent returns the next event of the given type, skipping others
+func waitForEvent(t *testing.T, events <-chan client.ConnectionEvent, eventType client.ConnectionEventType) client.ConnectionEvent {
+	t.Helper()
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.Type == eventType {
+				return event
+			}
+		case <-timeout:
+			t.Fatalf("Timed out waiting for %s event", eventType)
+			return client.ConnectionEvent{}
+		}
+	}
+}
+
+func TestReconnectAfterServerRestart(t *testing.T) {
+	const uri = "file:///watched"
+	m := newSubscribingTransport()
+	c := newInitializedClient(t, m, client.ClientConfig{Reconnect: fastReconnect})
+	events := recordEvents(c)
+
+	sub, err := c.SubscribeResource(context.Background(), uri)
+	if err != nil {
+		t.Fatalf("SubscribeResource failed: %v", err)
+	}
+
+	m.Close() // The server goes away
+	if lost := waitForEvent(t, events, client.ConnectionLost); lost.Err == nil {
+		t.Error("Expected ConnectionLost to carry the cause")
+	}
+	if restored := waitForEvent(t, events, client.ConnectionRestored); restored.Attempt != 1 {
+		t.Errorf("Expected restore on the first attempt, got %d", restored.Attempt)
+	}
+
+	if !c.IsInitialized() {
+		t.Fatal("Expected client to be initialized after reconnecting")
+	}
+	initializes := 0
+	for _, msg := range m.sentMessages() {
+		if msg.Method == "initialize" {
+			initializes++
+		}
+	}
+	if initializes != 2 {
+		t.Errorf("Expected the handshake to be repeated, got %d initialize requests", initializes)
+	}
+	if got := countSent(m, "resources/subscribe", uri); got != 2 {
+		t.Errorf("Expected subscription to be restored, got %d subscribe requests", got)
+	}
+
+	m.push(mcp.NewNotification(mcp.NotificationResourceUpdated, map[string]interface{}{"uri": uri}))
+	expectUpdate(t, sub)
+}
+
+func TestReconnectBacksOffAndGivesUp(t *testing.T) {
+	m := newMockTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{Reconnect: fastReconnect})
+	events := recordEvents(c)
+
+	m.failConnects = 10
+	m.Close()
+
+	for attempt := 1; attempt <= fastReconnect.MaxAttempts; attempt++ {
+		event := waitForEvent(t, events, client.ConnectionReconnecting)
+		if event.Attempt != attempt {
+			t.Fatalf("Expected attempt %d, got %d", attempt, event.Attempt)
+		}
+		if event.Delay <= 0 || event.Delay > 2*fastReconnect.MaxDelay {
+			t.Errorf("Attempt %d: unexpected delay %v", attempt, event.Delay)
+		}
+	}
+
+	failed := waitForEvent(t, events, client.ConnectionReconnectFailed)
+	if failed.Attempt != fastReconnect.MaxAttempts || failed.Err == nil {
+		t.Errorf("Unexpected failure event %+v", failed)
+	}
+	if c.IsConnected() || c.IsReconnecting() {
+		t.Error("Expected client to stay disconnected after giving up")
+	}
+}
+
+func TestReconnectRecoversAfterFailedAttempts(t *testing.T) {
+	m := newMockTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{Reconnect: fastReconnect})
+	events := recordEvents(c)
+
+	m.failConnects = 2
+	m.Close()
+
+	restored := waitForEvent(t, events, client.ConnectionRestored)
+	if restored.Attempt != 3 {
+		t.Errorf("Expected restore on attempt 3, got %d", restored.Attempt)
+	}
+	if !c.IsInitialized() {
+		t.Error("Expected client to be initialized after reconnecting")
+	}
+}
+
+func TestDisconnectStopsReconnect(t *testing.T) {
+	m := newMockTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{Reconnect: client.ReconnectConfig{
+		MaxAttempts:  -1,
+		InitialDelay: time.Hour,
+	}})
+	events := recordEvents(c)
+
+	m.Close()
+	waitForEvent(t, events, client.ConnectionReconnecting)
+	if !c.IsReconnecting() {
+		t.Fatal("Expected client to be reconnecting")
+	}
+
+	c.Disconnect()
+	if c.IsReconnecting() {
+		t.Error("Expected Disconnect to stop reconnecting")
+	}
+}
+
+func TestNoReconnectByDefault(t *testing.T) {
+	m := newMockTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{})
+	events := recordEvents(c)
+
+	m.Close()
+	waitForEvent(t, events, client.ConnectionLost)
+
+	time.Sleep(50 * time.Millisecond)
+	if c.IsConnected() || c.IsReconnecting() {
+		t.Error("Expected the client to stay disconnected without a reconnect policy")
+	}
+}
+
+func TestSendFailureClosesConnection(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "ping" {
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{}))
+		}
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+	events := recordEvents(c)
+	ctx := context.Background()
+
+	m.mu.Lock()
+	m.failSends = 1
+	m.mu.Unlock()
+	var transportErr *client.TransportError
+	if err := c.Ping(ctx); !errors.As(err, &transportErr) {
+		t.Fatalf("Expected a transport error, got %v", err)
+	}
+	waitForEvent(t, events, client.ConnectionLost)
+	if c.IsConnected() || m.IsConnected() {
+		t.Error("Expected the broken connection to be closed")
+	}
+
+	// A new connection starts from a fresh reader
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	if err := c.Ping(ctx); err != nil {
+		t.Errorf("Ping after reconnecting failed: %v", err)
+	}
+}
+
+func TestReconnectStdioServer(t *testing.T) {
+	t.Setenv(stdioServerEnv, "1") // Inherited by the server processes
+	c := newInitializedClient(t, transport.NewStdioTransport(os.Args[0], nil), client.ClientConfig{Reconnect: fastReconnect})
+	events := recordEvents(c)
+	ctx := context.Background()
+
+	if _, err := c.CallTool(ctx, "crash", nil); err == nil {
+		t.Fatal("Expected the call that crashed the server to fail")
+	}
+	waitForEvent(t, events, client.ConnectionLost)
+	waitForEvent(t, events, client.ConnectionRestored)
+
+	// The restarted server must survive the end of the reconnect run
+	time.Sleep(200 * time.Millisecond)
+	select {
+	case event := <-events:
+		t.Fatalf("Unexpected %s event after the connection was restored", event.Type)
+	default:
+	}
+	if !c.IsInitialized() {
+		t.Fatal("Expected client to stay initialized after reconnecting")
+	}
+	if err := c.Ping(ctx); err != nil {
+		t.Errorf("Ping after reconnecting failed: %v", err)
+	}
+}
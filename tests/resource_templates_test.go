@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+func TestListAndReadResourceTemplates(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		switch msg.Method {
+		case "resources/templates/list":
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{
+				"resourceTemplates": []interface{}{
+					map[string]interface{}{
+						"uriTemplate": "file:///{+path}",
+						"name":        "Project file",
+						"mimeType":    "text/plain",
+					},
+				},
+			}))
+		case "resources/read":
+			params, _ := msg.Params.(map[string]interface{})
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{
+				"contents": []interface{}{map[string]interface{}{"type": "text", "uri": params["uri"], "text": "package main"}},
+			}))
+		}
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	templates, err := c.ListResourceTemplates(context.Background())
+	if err != nil {
+		t.Fatalf("ListResourceTemplates failed: %v", err)
+	}
+	if len(templates) != 1 || templates[0].URITemplate != "file:///{+path}" {
+		t.Fatalf("Unexpected templates: %+v", templates)
+	}
+
+	vars, err := templates[0].Variables()
+	if err != nil || len(vars) != 1 || vars[0] != "path" {
+		t.Fatalf("Expected variable [path], got %v (err %v)", vars, err)
+	}
+
+	result, err := c.ReadResourceTemplate(context.Background(), templates[0], map[string]interface{}{"path": "src/main.go"})
+	if err != nil {
+		t.Fatalf("ReadResourceTemplate failed: %v", err)
+	}
+	if got := result.Contents[0].URI; got != "file:///src/main.go" {
+		t.Errorf("Expected expanded URI %q, got %q", "file:///src/main.go", got)
+	}
+}
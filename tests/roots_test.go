@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+func TestRootsList(t *testing.T) {
+	m, responses := newRecordingTransport()
+	c := newInitializedClient(t, m, client.ClientConfig{
+		Roots: []mcp.Root{{URI: "file:///work/project", Name: "project"}},
+	})
+
+	caps := initializeCapabilities(t, m)
+	roots, ok := caps["roots"].(map[string]interface{})
+	if !ok || roots["listChanged"] != true {
+		t.Errorf("Expected roots capability with listChanged, got %v", caps["roots"])
+	}
+
+	m.push(mcp.NewRequest(3, "roots/list", nil))
+	response := waitForResponse(t, responses, 3)
+	result, _ := response.Result.(map[string]interface{})
+	list, _ := result["roots"].([]interface{})
+	if len(list) != 1 {
+		t.Fatalf("Expected 1 root, got %v", result["roots"])
+	}
+
+	// Updating roots notifies the server
+	if err := c.AddRoot(mcp.Root{URI: "file:///work/docs"}); err != nil {
+		t.Fatalf("AddRoot failed: %v", err)
+	}
+	select {
+	case msg := <-responses:
+		if msg.Method != "notifications/roots/list_changed" {
+			t.Errorf("Expected roots list_changed notification, got %s", msg.Method)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No roots list_changed notification sent")
+	}
+
+	if got := len(c.Roots()); got != 2 {
+		t.Errorf("Expected 2 roots, got %d", got)
+	}
+}
+
+func TestRootsValidation(t *testing.T) {
+	m := newMockTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{Roots: []mcp.Root{}})
+
+	if err := c.SetRoots([]mcp.Root{{URI: "https://example.com"}}); err == nil {
+		t.Error("Expected error for non-file root URI")
+	}
+	if err := c.RemoveRoot("file:///missing"); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound removing unknown root, got %v", err)
+	}
+
+	root, err := mcp.NewFileRoot("/tmp/work", "work")
+	if err != nil {
+		t.Fatalf("NewFileRoot failed: %v", err)
+	}
+	if err := mcp.ValidateRoot(root); err != nil {
+		t.Errorf("NewFileRoot produced invalid root %q: %v", root.URI, err)
+	}
+}
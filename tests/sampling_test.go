@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// initializeCapabilities returns the capabilities the client sent in initialize
+func initializeCapabilities(t *testing.T, m *mockTransport) map[string]interface{} {
+	t.Helper()
+	for _, msg := range m.sentMessages() {
+		if msg.Method == "initialize" {
+			params, _ := msg.Params.(map[string]interface{})
+			caps, _ := params["capabilities"].(map[string]interface{})
+			return caps
+		}
+	}
+	t.Fatal("initialize request was not sent")
+	return nil
+}
+
+// waitForResponse waits for the client to answer the server request with the given ID
+func waitForResponse(t *testing.T, responses <-chan *mcp.Message, id float64) *mcp.Message {
+	t.Helper()
+	for {
+		select {
+		case msg := <-responses:
+			if msg.Method == "" && msg.ID == id {
+				return msg
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("No response to server request %v", id)
+			return nil
+		}
+	}
+}
+
+// newRecordingTransport returns a mock transport that forwards every client message to a channel
+func newRecordingTransport() (*mockTransport, chan *mcp.Message) {
+	responses := make(chan *mcp.Message, 10)
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		responses <- msg
+	})
+	return m, responses
+}
+
+func TestSamplingHandler(t *testing.T) {
+	m, responses := newRecordingTransport()
+
+	var received *mcp.CreateMessageRequest
+	handler := client.SamplingHandlerFunc(func(ctx context.Context, req *mcp.CreateMessageRequest) (*mcp.CreateMessageResponse, error) {
+		received = req
+		return &mcp.CreateMessageResponse{
+			Role:       "assistant",
+			Content:    mcp.Content{Type: "text", Text: "4"},
+			Model:      "test-model",
+			StopReason: mcp.StopReasonEndTurn,
+		}, nil
+	})
+	newInitializedClient(t, m, client.ClientConfig{SamplingHandler: handler})
+
+	if _, ok := initializeCapabilities(t, m)["sampling"]; !ok {
+		t.Error("Expected sampling capability to be advertised")
+	}
+
+	m.push(mcp.NewRequest(7, "sampling/createMessage", mcp.CreateMessageRequest{
+		Messages: []mcp.SamplingMessage{
+			{Role: "user", Content: mcp.Content{Type: "text", Text: "What is 2+2?"}},
+		},
+		MaxTokens: 10,
+	}))
+
+	response := waitForResponse(t, responses, 7)
+	if response.Error != nil {
+		t.Fatalf("Expected result, got error: %v", response.Error)
+	}
+	result, _ := response.Result.(map[string]interface{})
+	if result["model"] != "test-model" {
+		t.Errorf("Expected model 'test-model', got %v", result["model"])
+	}
+	if received == nil || received.MaxTokens != 10 || len(received.Messages) != 1 {
+		t.Errorf("Handler received unexpected request: %+v", received)
+	}
+}
+
+func TestSamplingHandlerWithoutResult(t *testing.T) {
+	m, responses := newRecordingTransport()
+	handler := client.SamplingHandlerFunc(func(ctx context.Context, req *mcp.CreateMessageRequest) (*mcp.CreateMessageResponse, error) {
+		return nil, nil
+	})
+	newInitializedClient(t, m, client.ClientConfig{SamplingHandler: handler})
+
+	m.push(mcp.NewRequest(9, "sampling/createMessage", mcp.CreateMessageRequest{MaxTokens: 10}))
+
+	response := waitForResponse(t, responses, 9)
+	if response.Error == nil || response.Error.Code != mcp.ErrorCodeInternalError {
+		t.Errorf("Expected internal error for a missing result, got %+v", response.Error)
+	}
+}
+
+func TestSamplingWithoutHandler(t *testing.T) {
+	m, responses := newRecordingTransport()
+	newInitializedClient(t, m, client.ClientConfig{})
+
+	if _, ok := initializeCapabilities(t, m)["sampling"]; ok {
+		t.Error("Sampling capability advertised without a handler")
+	}
+
+	m.push(mcp.NewRequest(8, "sampling/createMessage", mcp.CreateMessageRequest{MaxTokens: 10}))
+
+	response := waitForResponse(t, responses, 8)
+	if response.Error == nil || response.Error.Code != mcp.ErrorCodeMethodNotFound {
+		t.Errorf("Expected method not found error, got %+v", response.Error)
+	}
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/jsonschema"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// newWeatherTransport serves a weather tool with an output schema; structured
+// is returned as the tool's structured content
+func newWeatherTransport(structured map[string]interface{}) *mockTransport {
+	return newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		switch msg.Method {
+		case "tools/list":
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{
+				"tools": []interface{}{map[string]interface{}{
+					"name":         "weather",
+					"inputSchema":  map[string]interface{}{"type": "object"},
+					"outputSchema": weatherSchema,
+				}},
+			}))
+		case "tools/call":
+			result := map[string]interface{}{
+				"content": []interface{}{map[string]interface{}{"type": "text", "text": "22.5C and sunny"}},
+			}
+			if structured != nil {
+				result["structuredContent"] = structured
+			}
+			m.push(mcp.NewResponse(msg.ID, result))
+		}
+	})
+}
+
+func TestStructuredContentDecode(t *testing.T) {
+	m := newWeatherTransport(map[string]interface{}{"temperature": 22.5, "conditions": "sunny"})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	if _, err := c.ListTools(context.Background()); err != nil {
+		t.Fatalf("ListTools failed: %v", err)
+	}
+	result, err := c.CallTool(context.Background(), "weather", nil)
+	if err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+
+	var weather struct {
+		Temperature float64 `json:"temperature"`
+		Conditions  string  `json:"conditions"`
+	}
+	if err := result.DecodeStructuredContent(&weather); err != nil {
+		t.Fatalf("DecodeStructuredContent failed: %v", err)
+	}
+	if weather.Temperature != 22.5 || weather.Conditions != "sunny" {
+		t.Errorf("Unexpected decoded value: %+v", weather)
+	}
+}
+
+func TestStructuredContentValidatedAgainstOutputSchema(t *testing.T) {
+	m := newWeatherTransport(map[string]interface{}{"temperature": "hot", "conditions": "sunny"})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	// Before the schema is known the result is accepted as-is
+	if _, err := c.CallTool(context.Background(), "weather", nil); err != nil {
+		t.Fatalf("CallTool without a known schema failed: %v", err)
+	}
+
+	if _, err := c.ListTools(context.Background()); err != nil {
+		t.Fatalf("ListTools failed: %v", err)
+	}
+	_, err := c.CallTool(context.Background(), "weather", nil)
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected schema validation error, got %v", err)
+	}
+	if validationErr.Errors[0].Pointer != "/temperature" {
+		t.Errorf("Expected error at /temperature, got %v", validationErr.Errors)
+	}
+}
+
+func TestStructuredContentRequiredByOutputSchema(t *testing.T) {
+	m := newWeatherTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	_, err := c.CallTool(context.Background(), "weather", nil, client.WithOutputSchema(weatherSchema))
+	if !errors.Is(err, client.ErrInvalidResponse) {
+		t.Fatalf("Expected ErrInvalidResponse when a tool with an output schema returns no structured content, got %v", err)
+	}
+
+	result := &mcp.CallToolResponse{}
+	if err := result.DecodeStructuredContent(&struct{}{}); err == nil {
+		t.Error("Expected DecodeStructuredContent to fail without structured content")
+	}
+}
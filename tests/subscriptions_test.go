@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+// newSubscribingTransport creates a mock server that supports resource subscriptions
+func newSubscribingTransport() *mockTransport {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		switch msg.Method {
+		case "resources/subscribe", "resources/unsubscribe":
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{}))
+		}
+	})
+	m.capabilities = map[string]interface{}{
+		"resources": map[string]interface{}{"subscribe": true},
+	}
+	return m
+}
+
+// countSent counts the requests with the given method and uri param
+func countSent(m *mockTransport, method, uri string) int {
+	n := 0
+	for _, msg := range m.sentMessages() {
+		params, _ := msg.Params.(map[string]interface{})
+		if msg.Method == method && params["uri"] == uri {
+			n++
+		}
+	}
+	return n
+}
+
+// expectUpdate waits for an update on a subscription
+func expectUpdate(t *testing.T, sub *client.ResourceSubscription) mcp.ResourceUpdatedNotification {
+	t.Helper()
+	select {
+	case update, ok := <-sub.Updates():
+		if !ok {
+			t.Fatal("Updates channel closed unexpectedly")
+		}
+		return update
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for resource update")
+	}
+	return mcp.ResourceUpdatedNotification{}
+}
+
+func TestSubscribeResourceDeliversUpdates(t *testing.T) {
+	const uri = "file:///config.yaml"
+	m := newSubscribingTransport()
+	c := newInitializedClient(t, m, client.ClientConfig{})
+	ctx := context.Background()
+
+	first, err := c.SubscribeResource(ctx, uri)
+	if err != nil {
+		t.Fatalf("SubscribeResource failed: %v", err)
+	}
+	second, err := c.SubscribeResource(ctx, uri)
+	if err != nil {
+		t.Fatalf("Second SubscribeResource failed: %v", err)
+	}
+	if got := countSent(m, "resources/subscribe", uri); got != 1 {
+		t.Errorf("Expected one resources/subscribe for a shared URI, got %d", got)
+	}
+
+	m.push(mcp.NewNotification(mcp.NotificationResourceUpdated, map[string]interface{}{"uri": "file:///other"}))
+	m.push(mcp.NewNotification(mcp.NotificationResourceUpdated, map[string]interface{}{"uri": uri}))
+
+	for _, sub := range []*client.ResourceSubscription{first, second} {
+		if update := expectUpdate(t, sub); update.URI != uri {
+			t.Errorf("Expected update for %s, got %s", uri, update.URI)
+		}
+	}
+
+	// The server-side subscription is only dropped with the last local one
+	if err := first.Close(ctx); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if got := countSent(m, "resources/unsubscribe", uri); got != 0 {
+		t.Errorf("Expected no unsubscribe while a subscription remains, got %d", got)
+	}
+	if _, ok := <-first.Updates(); ok {
+		t.Error("Expected closed subscription's channel to be closed")
+	}
+
+	if err := second.Close(ctx); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if got := countSent(m, "resources/unsubscribe", uri); got != 1 {
+		t.Errorf("Expected one resources/unsubscribe, got %d", got)
+	}
+}
+
+func TestSubscribeResourceRequiresCapability(t *testing.T) {
+	m := newMockTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	if _, err := c.SubscribeResource(context.Background(), "file:///x"); !errors.Is(err, client.ErrCapabilityNotSupported) {
+		t.Fatalf("Expected ErrCapabilityNotSupported when server does not support subscriptions, got %v", err)
+	}
+}
+
+func TestSubscriptionsRestoredAfterReconnect(t *testing.T) {
+	const uri = "file:///watched"
+	m := newSubscribingTransport()
+	c := newInitializedClient(t, m, client.ClientConfig{})
+	ctx := context.Background()
+
+	sub, err := c.SubscribeResource(ctx, uri)
+	if err != nil {
+		t.Fatalf("SubscribeResource failed: %v", err)
+	}
+
+	c.Disconnect()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Reconnect failed: %v", err)
+	}
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize after reconnect failed: %v", err)
+	}
+
+	if got := countSent(m, "resources/subscribe", uri); got != 2 {
+		t.Errorf("Expected subscription to be restored after reconnect, got %d subscribe requests", got)
+	}
+
+	m.push(mcp.NewNotification(mcp.NotificationResourceUpdated, map[string]interface{}{"uri": uri}))
+	expectUpdate(t, sub)
+}
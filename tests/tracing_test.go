@@ -0,0 +1,240 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/tracing"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+)
+
+// findSpan returns the exported span with the given name
+func findSpan(t *testing.T, exporter *tracing.MemoryExporter, name string) tracing.SpanData {
+	t.Helper()
+	for _, span := range exporter.Spans() {
+		if span.Name == name {
+			return span
+		}
+	}
+	t.Fatalf("No span named %q in %+v", name, exporter.Spans())
+	return tracing.SpanData{}
+}
+
+func TestTracingSpanPerRequest(t *testing.T) {
+	exporter := tracing.NewMemoryExporter()
+	m := newEchoToolTransport()
+	c := newInitializedClient(t, m, client.ClientConfig{Tracer: tracing.NewTracer(exporter)})
+
+	if _, err := c.CallTool(context.Background(), "search", map[string]interface{}{"q": "mcp"}); err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+
+	span := findSpan(t, exporter, "tools/call search")
+	if span.Status != tracing.StatusOK || span.ParentSpanID != "" {
+		t.Errorf("Expected a successful root span, got %+v", span)
+	}
+	if span.Attributes[client.AttrMethod] != "tools/call" || span.Attributes[client.AttrToolName] != "search" {
+		t.Errorf("Unexpected attributes %v", span.Attributes)
+	}
+	if span.Attributes[client.AttrOutcome] != "ok" {
+		t.Errorf("Expected outcome ok, got %v", span.Attributes[client.AttrOutcome])
+	}
+	if id, _ := span.Attributes[client.AttrRequestID].(int64); id == 0 {
+		t.Errorf("Expected a request ID, got %v", span.Attributes[client.AttrRequestID])
+	}
+	if size, _ := span.Attributes[client.AttrRequestSize].(int); size == 0 {
+		t.Errorf("Expected a request size, got %v", span.Attributes[client.AttrRequestSize])
+	}
+
+	meta, _ := sentParams(m, "tools/call")["_meta"].(map[string]interface{})
+	want := "00-" + span.TraceID + "-" + span.SpanID + "-01"
+	if meta[tracing.TraceparentKey] != want {
+		t.Errorf("Expected traceparent %s in _meta, got %v", want, meta)
+	}
+}
+
+func TestTracingContinuesParentTrace(t *testing.T) {
+	parent, err := tracing.ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "vendor=opaque")
+	if err != nil {
+		t.Fatalf("ParseTraceparent failed: %v", err)
+	}
+
+	exporter := tracing.NewMemoryExporter()
+	m := newEchoToolTransport()
+	c := newInitializedClient(t, m, client.ClientConfig{Tracer: tracing.NewTracer(exporter)})
+
+	ctx := tracing.ContextWithSpanContext(context.Background(), parent)
+	if _, err := c.CallTool(ctx, "search", nil); err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+
+	span := findSpan(t, exporter, "tools/call search")
+	if span.TraceID != "4bf92f3577b34da6a3ce929d0e0e4736" || span.ParentSpanID != "00f067aa0ba902b7" {
+		t.Errorf("Expected span to join the parent trace, got %+v", span)
+	}
+	meta, _ := sentParams(m, "tools/call")["_meta"].(map[string]interface{})
+	if meta[tracing.TracestateKey] != "vendor=opaque" {
+		t.Errorf("Expected tracestate in _meta, got %v", meta)
+	}
+}
+
+func TestTracingRecordsServerErrors(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "tools/call" {
+			m.push(mcp.NewErrorResponse(msg.ID, mcp.ErrorCodeInvalidParams, "bad query", nil))
+		}
+	})
+	exporter := tracing.NewMemoryExporter()
+	c := newInitializedClient(t, m, client.ClientConfig{Tracer: tracing.NewTracer(exporter)})
+
+	if _, err := c.CallTool(context.Background(), "search", nil); err == nil {
+		t.Fatal("Expected CallTool to fail")
+	}
+
+	span := findSpan(t, exporter, "tools/call search")
+	if span.Status != tracing.StatusError || span.Error != "bad query" {
+		t.Errorf("Expected an error span, got %+v", span)
+	}
+	if span.Attributes[client.AttrOutcome] != "error" || span.Attributes[client.AttrErrorCode] != mcp.ErrorCodeInvalidParams {
+		t.Errorf("Unexpected attributes %v", span.Attributes)
+	}
+}
+
+// newStreamingTestServer is a minimal streamable HTTP MCP server that answers
+// initialize and tools/call, calling onRequest with every message it receives
+func newStreamingTestServer(onRequest func(r *http.Request, msg *mcp.Message)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg mcp.Message
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if onRequest != nil {
+			onRequest(r, &msg)
+		}
+
+		var response *mcp.Message
+		switch msg.Method {
+		case "initialize":
+			response = mcp.NewResponse(msg.ID, map[string]interface{}{
+				"protocolVersion": mcp.LatestProtocolVersion,
+				"capabilities":    map[string]interface{}{},
+				"serverInfo":      map[string]interface{}{"name": "test-server", "version": "1.0.0"},
+			})
+		case "tools/call":
+			response = mcp.NewResponse(msg.ID, map[string]interface{}{"content": []interface{}{}})
+		default:
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestTracingHTTPHeaders(t *testing.T) {
+	var mu sync.Mutex
+	headers := make(map[string]http.Header)
+
+	server := newStreamingTestServer(func(r *http.Request, msg *mcp.Message) {
+		mu.Lock()
+		headers[msg.Method] = r.Header.Clone()
+		mu.Unlock()
+	})
+	defer server.Close()
+
+	exporter := tracing.NewMemoryExporter()
+	c := client.NewClient(transport.NewStreamingHTTPTransport(server.URL, "/mcp"), client.ClientConfig{
+		Tracer: tracing.NewTracer(exporter),
+	})
+	ctx := context.Background()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer c.Disconnect()
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	if _, err := c.CallTool(ctx, "search", nil); err != nil {
+		t.Fatalf("CallTool failed: %v", err)
+	}
+
+	span := findSpan(t, exporter, "tools/call search")
+	mu.Lock()
+	defer mu.Unlock()
+	want := "00-" + span.TraceID + "-" + span.SpanID + "-01"
+	if got := headers["tools/call"].Get("traceparent"); got != want {
+		t.Errorf("Expected traceparent header %s, got %q", want, got)
+	}
+	if got := headers["notifications/initialized"].Get("traceparent"); got != "" {
+		t.Errorf("Expected no traceparent on notifications, got %q", got)
+	}
+}
+
+func TestJSONLExporter(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := tracing.NewTracer(tracing.NewJSONLExporter(&buf))
+
+	ctx, parent := tracer.Start(context.Background(), "agent step")
+	_, child := tracer.Start(ctx, "tools/call search")
+	child.SetAttribute("mcp.tool.name", "search")
+	child.End()
+	parent.End()
+	parent.End() // Ending twice exports once
+
+	var spans []tracing.SpanData
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var span tracing.SpanData
+		if err := decoder.Decode(&span); err != nil {
+			t.Fatalf("Invalid JSON line: %v", err)
+		}
+		spans = append(spans, span)
+	}
+
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+	if spans[0].Name != "tools/call search" || spans[0].Attributes["mcp.tool.name"] != "search" {
+		t.Errorf("Unexpected child span %+v", spans[0])
+	}
+	if spans[0].TraceID != spans[1].TraceID || spans[0].ParentSpanID != spans[1].SpanID {
+		t.Errorf("Expected the child to belong to the parent's trace: %+v", spans)
+	}
+}
+
+func TestParseTraceparent(t *testing.T) {
+	valid := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00"
+	sc, err := tracing.ParseTraceparent(valid, "")
+	if err != nil {
+		t.Fatalf("ParseTraceparent failed: %v", err)
+	}
+	if sc.Sampled {
+		t.Error("Expected flags 00 to be unsampled")
+	}
+	if sc.Traceparent() != valid {
+		t.Errorf("Expected round trip to %s, got %s", valid, sc.Traceparent())
+	}
+
+	invalid := []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"00-4bf92f3577b34da6a3ce929d0e0e473z-00f067aa0ba902b7-01",
+	}
+	for _, value := range invalid {
+		if _, err := tracing.ParseTraceparent(value, ""); err == nil {
+			t.Errorf("Expected %q to be rejected", value)
+		}
+	}
+}
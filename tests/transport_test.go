@@ -1,9 +1,20 @@
 package tests
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
 	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+
+	"github.com/gorilla/websocket"
 )
 
 func TestTransportTypes(t *testing.T) {
@@ -102,3 +113,96 @@ func TestTransportCreationEdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestWebSocketTransportReconnect(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			messageType, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			conn.WriteMessage(messageType, data) // Echo
+		}
+	}))
+	defer server.Close()
+
+	wsTransport := transport.NewWebSocketTransport("ws" + strings.TrimPrefix(server.URL, "http"))
+	ctx := context.Background()
+
+	for round := 1; round <= 2; round++ {
+		if err := wsTransport.Connect(ctx); err != nil {
+			t.Fatalf("Round %d: Connect failed: %v", round, err)
+		}
+		if err := wsTransport.Send(mcp.NewRequest(int64(round), "ping", nil)); err != nil {
+			t.Fatalf("Round %d: Send failed: %v", round, err)
+		}
+		message, err := wsTransport.Receive()
+		if err != nil {
+			t.Fatalf("Round %d: Receive failed: %v", round, err)
+		}
+		if message.Method != "ping" {
+			t.Errorf("Round %d: expected echoed ping, got %+v", round, message)
+		}
+		if err := wsTransport.Close(); err != nil {
+			t.Fatalf("Round %d: Close failed: %v", round, err)
+		}
+	}
+}
+
+func TestSSEStreamOutlivesTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, "event: endpoint\ndata: /messages\n\n")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done() // An idle stream, held open until the client leaves
+			return
+		}
+
+		var msg mcp.Message
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch msg.Method {
+		case "initialize":
+			json.NewEncoder(w).Encode(mcp.NewResponse(msg.ID, map[string]interface{}{
+				"protocolVersion": mcp.LatestProtocolVersion,
+				"capabilities":    map[string]interface{}{},
+				"serverInfo":      map[string]interface{}{"name": "sse-server", "version": "1.0.0"},
+			}))
+		case "ping":
+			json.NewEncoder(w).Encode(mcp.NewResponse(msg.ID, map[string]interface{}{}))
+		default:
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer server.Close()
+
+	sseTransport := transport.NewSSETransport(server.URL, "/sse")
+	sseTransport.SetTimeout(500 * time.Millisecond)
+	c := client.NewClient(sseTransport, client.ClientConfig{})
+	ctx := context.Background()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer c.Disconnect()
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	time.Sleep(1200 * time.Millisecond) // Well past the transport timeout
+
+	if !c.IsConnected() {
+		t.Fatal("Expected the SSE stream to stay open past the timeout")
+	}
+	if err := c.Ping(ctx); err != nil {
+		t.Errorf("Ping failed: %v", err)
+	}
+}
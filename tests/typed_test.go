@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+type addArgs struct {
+	A int `json:"a"`
+	B int `json:"b"`
+}
+
+type addResult struct {
+	Sum int `json:"sum"`
+}
+
+// newTypedToolTransport answers tools/call with the result produced by respond
+func newTypedToolTransport(respond func(arguments map[string]interface{}) map[string]interface{}) *mockTransport {
+	return newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "tools/call" {
+			params, _ := msg.Params.(map[string]interface{})
+			arguments, _ := params["arguments"].(map[string]interface{})
+			m.push(mcp.NewResponse(msg.ID, respond(arguments)))
+		}
+	})
+}
+
+func textResult(text string) map[string]interface{} {
+	return map[string]interface{}{"content": []interface{}{map[string]interface{}{"type": "text", "text": text}}}
+}
+
+func TestCallToolAsStructuredContent(t *testing.T) {
+	m := newTypedToolTransport(func(arguments map[string]interface{}) map[string]interface{} {
+		sum := arguments["a"].(float64) + arguments["b"].(float64)
+		result := textResult("ignored")
+		result["structuredContent"] = map[string]interface{}{"sum": sum}
+		return result
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	result, err := client.CallToolAs[addArgs, addResult](context.Background(), c, "add", addArgs{A: 2, B: 3})
+	if err != nil {
+		t.Fatalf("CallToolAs failed: %v", err)
+	}
+	if result.Sum != 5 {
+		t.Errorf("Expected sum 5, got %d", result.Sum)
+	}
+}
+
+func TestCallToolAsJSONText(t *testing.T) {
+	m := newTypedToolTransport(func(map[string]interface{}) map[string]interface{} {
+		return textResult(`{"sum": 7}`)
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+	ctx := context.Background()
+
+	result, err := client.CallToolAs[*addArgs, addResult](ctx, c, "add", nil)
+	if err != nil {
+		t.Fatalf("CallToolAs failed: %v", err)
+	}
+	if result.Sum != 7 {
+		t.Errorf("Expected sum 7, got %d", result.Sum)
+	}
+
+	text, err := client.CallToolAs[map[string]interface{}, string](ctx, c, "add", nil)
+	if err != nil || text != `{"sum": 7}` {
+		t.Errorf("Expected raw text, got %q (%v)", text, err)
+	}
+}
+
+func TestCallToolAsErrors(t *testing.T) {
+	m := newTypedToolTransport(func(arguments map[string]interface{}) map[string]interface{} {
+		if arguments["a"] == float64(0) {
+			result := textResult("division by zero")
+			result["isError"] = true
+			return result
+		}
+		return textResult("not json")
+	})
+	c := newInitializedClient(t, m, client.ClientConfig{})
+	ctx := context.Background()
+
+	_, err := client.CallToolAs[addArgs, addResult](ctx, c, "divide", addArgs{A: 0})
+	var toolErr *client.ToolError
+	if !errors.As(err, &toolErr) {
+		t.Fatalf("Expected *ToolError, got %v", err)
+	}
+	if toolErr.Tool != "divide" || err.Error() != `tool "divide" failed: division by zero` {
+		t.Errorf("Unexpected tool error %q", err.Error())
+	}
+
+	if _, err := client.CallToolAs[addArgs, addResult](ctx, c, "divide", addArgs{A: 1}); !errors.Is(err, client.ErrInvalidResponse) {
+		t.Errorf("Expected ErrInvalidResponse for undecodable text, got %v", err)
+	}
+
+	if _, err := client.CallToolAs[int, addResult](ctx, c, "divide", 3); err == nil {
+		t.Error("Expected arguments that are not an object to be rejected")
+	}
+}
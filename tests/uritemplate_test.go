@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/uritemplate"
+)
+
+// Variables and expected expansions from RFC 6570 section 3.2
+var rfcVars = map[string]interface{}{
+	"var":   "value",
+	"hello": "Hello World!",
+	"path":  "/foo/bar",
+	"empty": "",
+	"x":     "1024",
+	"y":     "768",
+	"list":  []string{"red", "green", "blue"},
+	"keys":  map[string]string{"comma": ",", "dot": ".", "semi": ";"},
+}
+
+func TestURITemplateExpansion(t *testing.T) {
+	tests := []struct {
+		template string
+		want     string
+	}{
+		// Level 1 and 2
+		{"{var}", "value"},
+		{"{hello}", "Hello%20World%21"},
+		{"{+hello}", "Hello%20World!"},
+		{"{+path}/here", "/foo/bar/here"},
+		{"X{#var}", "X#value"},
+		{"file:///{+path}", "file:////foo/bar"},
+		// Level 3
+		{"map?{x,y}", "map?1024,768"},
+		{"{x,hello,y}", "1024,Hello%20World%21,768"},
+		{"X{.var}", "X.value"},
+		{"{/var,x}/here", "/value/1024/here"},
+		{"{;x,y,empty}", ";x=1024;y=768;empty"},
+		{"{?x,y,empty}", "?x=1024&y=768&empty="},
+		{"?fixed=yes{&x}", "?fixed=yes&x=1024"},
+		{"{?undef}", ""},
+		// Level 4
+		{"{var:3}", "val"},
+		{"{list}", "red,green,blue"},
+		{"{list*}", "red,green,blue"},
+		{"{keys}", "comma,%2C,dot,.,semi,%3B"},
+		{"{keys*}", "comma=%2C,dot=.,semi=%3B"},
+		{"{+path:6}/here", "/foo/b/here"},
+		{"{/list*,path:4}", "/red/green/blue/%2Ffoo"},
+		{"{;list*}", ";list=red;list=green;list=blue"},
+		{"{?keys*}", "?comma=%2C&dot=.&semi=%3B"},
+		{"{&list*}", "&list=red&list=green&list=blue"},
+	}
+
+	for _, tt := range tests {
+		tmpl, err := uritemplate.Parse(tt.template)
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %v", tt.template, err)
+			continue
+		}
+		got, err := tmpl.Expand(rfcVars)
+		if err != nil {
+			t.Errorf("Expand(%q) failed: %v", tt.template, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Expand(%q) = %q, want %q", tt.template, got, tt.want)
+		}
+	}
+}
+
+func TestURITemplateVariables(t *testing.T) {
+	tmpl := uritemplate.MustParse("db://{schema}/{table}{?limit,schema}")
+	vars := tmpl.Variables()
+	want := []string{"schema", "table", "limit"}
+	if len(vars) != len(want) {
+		t.Fatalf("Expected variables %v, got %v", want, vars)
+	}
+	for i := range want {
+		if vars[i] != want[i] {
+			t.Errorf("Expected variables %v, got %v", want, vars)
+		}
+	}
+}
+
+func TestURITemplateParseErrors(t *testing.T) {
+	for _, template := range []string{"{var", "var}", "{}", "{!var}", "{va r}", "{var:0}", "{var:abc}"} {
+		if _, err := uritemplate.Parse(template); err == nil {
+			t.Errorf("Expected Parse(%q) to fail", template)
+		}
+	}
+
+	tmpl := uritemplate.MustParse("{list:2}")
+	if _, err := tmpl.Expand(rfcVars); err == nil {
+		t.Error("Expected prefix modifier on a list to fail")
+	}
+}
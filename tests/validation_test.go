@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/jsonschema"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+)
+
+var searchInputSchema = map[string]interface{}{
+	"type":     "object",
+	"required": []interface{}{"query"},
+	"properties": map[string]interface{}{
+		"query": map[string]interface{}{"type": "string", "minLength": float64(1)},
+		"limit": map[string]interface{}{"type": "integer", "minimum": float64(1), "maximum": float64(50)},
+	},
+}
+
+// newSearchTransport serves a search tool with searchInputSchema
+func newSearchTransport() *mockTransport {
+	return newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		switch msg.Method {
+		case "tools/list":
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{
+				"tools": []interface{}{map[string]interface{}{"name": "search", "inputSchema": searchInputSchema}},
+			}))
+		case "tools/call":
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{"content": []interface{}{}}))
+		}
+	})
+}
+
+func TestCallToolValidatesArguments(t *testing.T) {
+	m := newSearchTransport()
+	c := newInitializedClient(t, m, client.ClientConfig{})
+	ctx := context.Background()
+
+	if _, err := c.ListTools(ctx); err != nil {
+		t.Fatalf("ListTools failed: %v", err)
+	}
+
+	_, err := c.CallTool(ctx, "search", map[string]interface{}{"limit": 100})
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected *jsonschema.ValidationError, got %v", err)
+	}
+	pointers := make(map[string]bool)
+	for _, fieldErr := range validationErr.Errors {
+		pointers[fieldErr.Pointer] = true
+	}
+	if !pointers["/query"] || !pointers["/limit"] || len(pointers) != 2 {
+		t.Errorf("Expected failures at /query and /limit, got %v", validationErr.Errors)
+	}
+	if got := countRequests(m, "tools/call"); got != 0 {
+		t.Errorf("Expected invalid arguments not to be sent, got %d tools/call", got)
+	}
+
+	if _, err := c.CallTool(ctx, "search", map[string]interface{}{"query": "golang", "limit": 10}); err != nil {
+		t.Errorf("Expected valid arguments to be sent, got %v", err)
+	}
+}
+
+func TestCallToolArgumentValidationDisabled(t *testing.T) {
+	m := newSearchTransport()
+	c := newInitializedClient(t, m, client.ClientConfig{DisableArgumentValidation: true})
+	ctx := context.Background()
+
+	if _, err := c.ListTools(ctx); err != nil {
+		t.Fatalf("ListTools failed: %v", err)
+	}
+	if _, err := c.CallTool(ctx, "search", nil); err != nil {
+		t.Errorf("Expected arguments to be sent unchecked, got %v", err)
+	}
+}
+
+func TestCallToolValidatesWithCatalog(t *testing.T) {
+	m := newSearchTransport()
+	c := newInitializedClient(t, m, client.ClientConfig{CacheCatalog: true})
+
+	// The schema is found by loading the catalog, without an explicit ListTools
+	_, err := c.CallTool(context.Background(), "search", nil)
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected missing query to be rejected, got %v", err)
+	}
+}
+
+func TestCallToolSchemaForgottenOnToolsChanged(t *testing.T) {
+	server := &catalogServer{}
+	server.setTools(map[string]interface{}{"name": "search", "inputSchema": searchInputSchema})
+	m := server.transport()
+	c := newInitializedClient(t, m, client.ClientConfig{})
+	ctx := context.Background()
+
+	if _, err := c.ListTools(ctx); err != nil {
+		t.Fatalf("ListTools failed: %v", err)
+	}
+	arguments := map[string]interface{}{"q": "mcp"}
+	if _, err := c.CallTool(ctx, "search", arguments); err == nil {
+		t.Fatal("Expected arguments without query to be rejected")
+	}
+
+	server.setTools(map[string]interface{}{"name": "search", "inputSchema": map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"q"},
+	}})
+	m.push(mcp.NewNotification(mcp.NotificationToolListChanged, nil))
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		_, err := c.CallTool(ctx, "search", arguments)
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the stale schema to be forgotten after tools/list_changed, got %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestListToolsReplacesSchemas(t *testing.T) {
+	server := &catalogServer{}
+	server.setTools(
+		map[string]interface{}{"name": "search", "inputSchema": searchInputSchema},
+		map[string]interface{}{"name": "weather", "inputSchema": map[string]interface{}{"type": "object"}, "outputSchema": weatherSchema},
+	)
+	m := server.transport()
+	c := newInitializedClient(t, m, client.ClientConfig{})
+	ctx := context.Background()
+
+	if _, err := c.ListTools(ctx); err != nil {
+		t.Fatalf("ListTools failed: %v", err)
+	}
+	server.setTools()
+	if _, err := c.ListTools(ctx); err != nil {
+		t.Fatalf("ListTools failed: %v", err)
+	}
+
+	// Neither schema of the dropped tools applies any more
+	if _, err := c.CallTool(ctx, "search", nil); err != nil {
+		t.Errorf("Expected the dropped input schema to be forgotten, got %v", err)
+	}
+	if _, err := c.CallTool(ctx, "weather", nil); err != nil {
+		t.Errorf("Expected the dropped output schema to be forgotten, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/client"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/mcp"
+	"github.com/kunalkushwaha/mcp-navigator-go/pkg/transport"
+)
+
+// sentInitialize returns the params of the initialize request
+func sentInitialize(m *mockTransport) map[string]interface{} {
+	for _, msg := range m.sentMessages() {
+		if msg.Method == "initialize" {
+			params, _ := msg.Params.(map[string]interface{})
+			return params
+		}
+	}
+	return nil
+}
+
+func TestProtocolVersionNegotiatedLatest(t *testing.T) {
+	m := newMockTransport(nil)
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	if got := sentInitialize(m)["protocolVersion"]; got != mcp.LatestProtocolVersion {
+		t.Errorf("Expected request for %s, got %v", mcp.LatestProtocolVersion, got)
+	}
+	if got := c.ProtocolVersion(); got != mcp.LatestProtocolVersion {
+		t.Errorf("Expected negotiated %s, got %s", mcp.LatestProtocolVersion, got)
+	}
+
+	c.Disconnect()
+	if got := c.ProtocolVersion(); got != "" {
+		t.Errorf("Expected no protocol version after disconnect, got %s", got)
+	}
+}
+
+func TestProtocolVersionDowngrade(t *testing.T) {
+	m := newMockTransport(func(m *mockTransport, msg *mcp.Message) {
+		if msg.Method == "completion/complete" {
+			m.push(mcp.NewResponse(msg.ID, map[string]interface{}{"completion": map[string]interface{}{"values": []interface{}{}}}))
+		}
+	})
+	m.protocolVersion = mcp.ProtocolVersion20241105
+	c := newInitializedClient(t, m, client.ClientConfig{})
+
+	if got := c.ProtocolVersion(); got != mcp.ProtocolVersion20241105 {
+		t.Fatalf("Expected downgrade to %s, got %s", mcp.ProtocolVersion20241105, got)
+	}
+
+	// Completion context is a 2025-06-18 field and must not reach an older server
+	_, err := c.CompletePromptArgument(context.Background(), "p", "b", "x", map[string]string{"a": "1"})
+	if err != nil {
+		t.Fatalf("CompletePromptArgument failed: %v", err)
+	}
+	for _, msg := range m.sentMessages() {
+		if msg.Method != "completion/complete" {
+			continue
+		}
+		if _, ok := msg.Params.(map[string]interface{})["context"]; ok {
+			t.Error("Completion context sent to a 2024-11-05 server")
+		}
+	}
+}
+
+func TestProtocolVersionUnsupported(t *testing.T) {
+	m := newMockTransport(nil)
+	m.protocolVersion = "1999-01-01"
+	c := client.NewClient(m, client.ClientConfig{Timeout: 5 * time.Second})
+
+	ctx := context.Background()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer c.Disconnect()
+
+	err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"})
+	if !errors.Is(err, client.ErrUnsupportedProtocolVersion) {
+		t.Fatalf("Expected ErrUnsupportedProtocolVersion, got %v", err)
+	}
+	if c.IsInitialized() {
+		t.Error("Client must not be initialized after a version mismatch")
+	}
+
+	bad := client.NewClient(newMockTransport(nil), client.ClientConfig{ProtocolVersion: "2023-01-01"})
+	bad.Connect(ctx)
+	defer bad.Disconnect()
+	if err := bad.Initialize(ctx, mcp.ClientInfo{Name: "test-client"}); !errors.Is(err, client.ErrUnsupportedProtocolVersion) {
+		t.Errorf("Expected unsupported requested version to fail, got %v", err)
+	}
+}
+
+func TestRequestedVersionGatesCapabilities(t *testing.T) {
+	m := newMockTransport(nil)
+	elicit := client.ElicitationHandlerFunc(func(ctx context.Context, r *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+		return &mcp.ElicitResult{Action: mcp.ElicitActionDecline}, nil
+	})
+	newInitializedClient(t, m, client.ClientConfig{ProtocolVersion: mcp.ProtocolVersion20250326, ElicitationHandler: elicit})
+
+	params := sentInitialize(m)
+	if params["protocolVersion"] != mcp.ProtocolVersion20250326 {
+		t.Errorf("Expected configured version to be requested, got %v", params["protocolVersion"])
+	}
+	capabilities, _ := params["capabilities"].(map[string]interface{})
+	if _, ok := capabilities["elicitation"]; ok {
+		t.Error("Elicitation capability advertised for a version that predates it")
+	}
+}
+
+func TestStreamingHTTPSendsProtocolVersionHeader(t *testing.T) {
+	var mu sync.Mutex
+	headers := make(map[string]string)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg mcp.Message
+		json.NewDecoder(r.Body).Decode(&msg)
+
+		mu.Lock()
+		headers[msg.Method] = r.Header.Get("MCP-Protocol-Version")
+		mu.Unlock()
+
+		switch msg.Method {
+		case "initialize":
+			json.NewEncoder(w).Encode(mcp.NewResponse(msg.ID, map[string]interface{}{
+				"protocolVersion": mcp.ProtocolVersion20250618,
+				"capabilities":    map[string]interface{}{},
+				"serverInfo":      map[string]interface{}{"name": "http", "version": "1"},
+			}))
+		case "ping":
+			json.NewEncoder(w).Encode(mcp.NewResponse(msg.ID, map[string]interface{}{}))
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient(transport.NewStreamingHTTPTransport(server.URL, "/mcp"), client.ClientConfig{Timeout: 5 * time.Second})
+	ctx := context.Background()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer c.Disconnect()
+	if err := c.Initialize(ctx, mcp.ClientInfo{Name: "test-client", Version: "1.0.0"}); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	if err := c.Ping(ctx); err != nil {
+		t.Fatalf("Ping failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if headers["initialize"] != "" {
+		t.Errorf("Expected no version header before negotiation, got %q", headers["initialize"])
+	}
+	if headers["ping"] != mcp.ProtocolVersion20250618 {
+		t.Errorf("Expected MCP-Protocol-Version %s after negotiation, got %q", mcp.ProtocolVersion20250618, headers["ping"])
+	}
+}